@@ -0,0 +1,36 @@
+package auth0
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func dataSourceBrandingTheme() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(newBrandingTheme().Schema)
+	s["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the default theme",
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceBrandingThemeRead,
+		Description: "Retrieve the default Universal Login theme of the tenant",
+		Schema:      s,
+	}
+}
+
+func dataSourceBrandingThemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	var t *brandingTheme
+	if err := api.Request("GET", api.URI("branding", "themes", "default"), &t, management.Context(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(auth0.StringValue(t.ID))
+	assignBrandingTheme(d, t)
+	return nil
+}
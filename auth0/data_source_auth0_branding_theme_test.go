@@ -0,0 +1,28 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceBrandingTheme(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrandingThemeConfig + `
+data "auth0_branding_theme" "default" {
+	depends_on = [ auth0_branding_theme.my_theme ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.auth0_branding_theme.default", "id", "auth0_branding_theme.my_theme", "id"),
+					resource.TestCheckResourceAttr("data.auth0_branding_theme.default", "display_name", "My theme"),
+					resource.TestCheckResourceAttr("data.auth0_branding_theme.default", "colors.0.primary_button", "#0059d6"),
+					resource.TestCheckResourceAttr("data.auth0_branding_theme.default", "fonts.0.title.0.bold", "true"),
+				),
+			},
+		},
+	})
+}
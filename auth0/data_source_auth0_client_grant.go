@@ -0,0 +1,43 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func dataSourceClientGrant() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(newClientGrant().Schema, "client_id", "audience")
+	s["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the client grant",
+	}
+	// These attributes only exist in the state of the resource.
+	for _, k := range []string{"adopt_existing", "validate_scopes"} {
+		delete(s, k)
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceClientGrantRead,
+		Description: "Retrieve the grant of an auth0 client for an audience",
+		Schema:      s,
+	}
+}
+
+func dataSourceClientGrantRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientID := d.Get("client_id").(string)
+	audience := d.Get("audience").(string)
+	g, err := findClientGrant(ctx, m.(*management.Management), clientID, audience)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if g == nil {
+		return diag.FromErr(fmt.Errorf("no grant found for the client %q and the audience %q", clientID, audience))
+	}
+	d.SetId(g.GetID())
+	return readClientGrant(ctx, d, m)
+}
@@ -0,0 +1,34 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceClientGrant(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccClientGrantConfigUpdate+`
+
+data "auth0_client_grant" "my_client_grant" {
+	client_id = auth0_client_grant.my_client_grant.client_id
+	audience = auth0_client_grant.my_client_grant.audience
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.auth0_client_grant.my_client_grant", "id", "auth0_client_grant.my_client_grant", "id"),
+					resource.TestCheckResourceAttr("data.auth0_client_grant.my_client_grant", "scope.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.auth0_client_grant.my_client_grant", "scope.*", "create:foo"),
+				),
+			},
+		},
+	})
+}
@@ -104,7 +104,7 @@ func TestAccDataSourceConnection(t *testing.T) {
 							max = 40
 						}
 					}
-					enabled_database_customization = false
+					enabled_database_customization = true
 					brute_force_protection = true
 					import_mode = false
 					requires_username = true
@@ -134,7 +134,7 @@ func TestAccDataSourceConnection(t *testing.T) {
 					resource.TestCheckResourceAttr("data.auth0_connection.my_connection", "options.0.password_no_personal_info.0.enable", "true"),
 					resource.TestCheckResourceAttr("data.auth0_connection.my_connection", "options.0.password_dictionary.0.enable", "true"),
 					resource.TestCheckResourceAttr("data.auth0_connection.my_connection", "options.0.password_complexity_options.0.min_length", "6"),
-					resource.TestCheckResourceAttr("data.auth0_connection.my_connection", "options.0.enabled_database_customization", "false"),
+					resource.TestCheckResourceAttr("data.auth0_connection.my_connection", "options.0.enabled_database_customization", "true"),
 					resource.TestCheckResourceAttr("data.auth0_connection.my_connection", "options.0.brute_force_protection", "true"),
 					resource.TestCheckResourceAttr("data.auth0_connection.my_connection", "options.0.import_mode", "false"),
 					resource.TestCheckResourceAttr("data.auth0_connection.my_connection", "options.0.disable_signup", "false"),
@@ -165,7 +165,7 @@ func TestAccDataSourceConnection(t *testing.T) {
 					password_no_personal_info {
 						enable = true
 					}
-					enabled_database_customization = false
+					enabled_database_customization = true
 					brute_force_protection = false
 					import_mode = false
 					disable_signup = false
@@ -8,63 +8,21 @@ import (
 )
 
 func dataSourceCustomDomain() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(newCustomDomain().Schema)
+	s["custom_domain_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "ID of the custom domain",
+	}
+	s["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the custom domain",
+	}
 	return &schema.Resource{
 		ReadContext: dataSourceCustomDomainRead,
 		Description: `A custom domain configured for this tenant`,
-
-		Schema: map[string]*schema.Schema{
-			"custom_domain_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "ID of the custom domain",
-			},
-			"id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "ID of the custom domain",
-			},
-			"domain": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Name of the custom domain",
-			},
-			"type": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Description: "Provisioning type for the custom domain. Valid options are: auth0_managed_certs, " +
-					"self_managed_certs",
-			},
-			"primary": {
-				Type:        schema.TypeBool,
-				Computed:    true,
-				Description: "Indicates whether or not this is a primary domain",
-			},
-			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Description: "Configuration status for the custom domain. Options include `disabled`, `pending`, " +
-					"`pending_verification`, and `ready`",
-			},
-			"verification_method": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Domain verification method. Options include `txt`",
-			},
-			"verification": {
-				Type:        schema.TypeList,
-				Computed:    true,
-				Description: "Configuration settings for verification",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"methods": {
-							Type:     schema.TypeList,
-							Elem:     schema.TypeMap,
-							Computed: true,
-						},
-					},
-				},
-			},
-		},
+		Schema:      s,
 	}
 }
 
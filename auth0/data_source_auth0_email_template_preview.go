@@ -0,0 +1,113 @@
+package auth0
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/liquid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func dataSourceEmailTemplatePreview() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEmailTemplatePreviewRead,
+		Description: `Render an email template with a sample context, for example to review the output of a template
+in CI. The template is either read from the tenant with ` + "`template`" + `, or given with ` + "`body`" + ` and ` + "`subject`" + `.
+
+Only the subset of Liquid used by the Auth0 email templates is supported. Filters which aren't supported leave
+the value untouched and produce a warning.`,
+		Schema: map[string]*schema.Schema{
+			"template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the email template of the tenant to render, e.g. `welcome_email`",
+				ConflictsWith: []string{"body", "subject"},
+				AtLeastOneOf:  []string{"template", "body", "subject"},
+			},
+			"body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Body of the email template to render",
+				ValidateFunc: validateEmailTemplateLiquid,
+			},
+			"subject": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Subject of the email template to render",
+				ValidateFunc: validateEmailTemplateLiquid,
+			},
+			"context": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "JSON object with the variables given to the template, e.g. `user` and `application`",
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"rendered_body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered body",
+			},
+			"rendered_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered subject",
+			},
+		},
+	}
+}
+
+func dataSourceEmailTemplatePreviewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body := d.Get("body").(string)
+	subject := d.Get("subject").(string)
+	if template, ok := d.GetOk("template"); ok {
+		api := m.(*management.Management)
+		e, err := api.EmailTemplate.Read(template.(string), management.Context(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		body = auth0.StringValue(e.Body)
+		subject = auth0.StringValue(e.Subject)
+	}
+
+	vars, err := JSON(d, "context")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	renderedBody, warnings, err := liquid.Render(body, vars)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to render the body: %w", err))
+	}
+	diags = append(diags, emailTemplatePreviewWarnings("body", warnings)...)
+	renderedSubject, warnings, err := liquid.Render(subject, vars)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to render the subject: %w", err))
+	}
+	diags = append(diags, emailTemplatePreviewWarnings("subject", warnings)...)
+
+	sum := sha256.Sum256([]byte(renderedSubject + "\n" + renderedBody))
+	d.SetId(hex.EncodeToString(sum[:]))
+	_ = d.Set("rendered_body", renderedBody)
+	_ = d.Set("rendered_subject", renderedSubject)
+	return diags
+}
+
+func emailTemplatePreviewWarnings(k string, warnings []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, w := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The preview of the %s may differ from the email sent by Auth0", k),
+			Detail:   w,
+		})
+	}
+	return diags
+}
@@ -0,0 +1,53 @@
+package auth0
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceEmailTemplatePreview(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "auth0_email_template_preview" "welcome" {
+	subject = "Welcome to {{ application.name }}"
+	body    = "<p>Hello {{ user.name | default: 'there' }}!</p>{% if user.email_verified %}<p>Verified</p>{% endif %}"
+	context = jsonencode({
+		application = { name = "Acme" }
+		user        = { email_verified = true }
+	})
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.auth0_email_template_preview.welcome", "rendered_subject", "Welcome to Acme"),
+					resource.TestCheckResourceAttr("data.auth0_email_template_preview.welcome", "rendered_body", "<p>Hello there!</p><p>Verified</p>"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceEmailTemplatePreviewRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceEmailTemplatePreview().Schema, map[string]interface{}{
+		"subject": "Your code is {{ code }}",
+		"body":    "{% for f in user.factors %}{{ forloop.index }}. {{ f | upcase }} {% endfor %}",
+		"context": `{"code": 123456, "user": {"factors": ["sms", "otp"]}}`,
+	})
+	if diags := dataSourceEmailTemplatePreviewRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if v := d.Get("rendered_subject"); v != "Your code is 123456" {
+		t.Errorf("unexpected rendered_subject %q", v)
+	}
+	if v := d.Get("rendered_body"); v != "1. SMS 2. OTP " {
+		t.Errorf("unexpected rendered_body %q", v)
+	}
+	if d.Id() == "" {
+		t.Error("expected the ID to be set")
+	}
+}
@@ -1,7 +1,10 @@
 package auth0
 
 import (
+	"context"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -10,6 +13,7 @@ import (
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func init() {
@@ -116,3 +120,32 @@ data "auth0_resource_server" "my_resource_server" {
 		},
 	})
 }
+
+func TestDataSourceResourceServerReadScopes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "rs_1",
+			"identifier": "https://api.example.com",
+			"scopes": [{"value": "read:messages", "description": "Read messages"}]
+		}`))
+	}))
+	defer server.Close()
+
+	api, err := management.New(server.URL, management.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceResourceServer().Schema, map[string]interface{}{
+		"id": "rs_1",
+	})
+	if diags := dataSourceResourceServerRead(context.Background(), d, api); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if v := d.Get("scopes.#"); v != 1 {
+		t.Errorf("expected 1 scope, got %v", v)
+	}
+	if !hasResourceServerScope(d, "read:messages") {
+		t.Errorf("expected the scope read:messages, got %v", d.Get("scopes"))
+	}
+}
@@ -8,55 +8,16 @@ import (
 )
 
 func dataSourceRole() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(newRole().Schema)
+	s["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "ID of the role to retrieve",
+	}
 	return &schema.Resource{
 		ReadContext: dataSourceRoleRead,
 		Description: "Retrieve an auth0 role",
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "ID of the role to retrieve",
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Name for this role",
-			},
-			"description": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Role's description",
-			},
-			"permissions": {
-				Type:        schema.TypeSet,
-				Computed:    true,
-				Description: "Configuration settings for permissions (scopes) attached to the role",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Name of the permission (scope)",
-						},
-						"description": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Description of this permission",
-						},
-						"resource_server_identifier": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Unique identifier for the resource server",
-						},
-						"resource_server_name": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Resource server (API) name this permission is for",
-						},
-					},
-				},
-			},
-		},
+		Schema:      s,
 	}
 }
 
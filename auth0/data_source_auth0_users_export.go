@@ -0,0 +1,208 @@
+package auth0
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func dataSourceUsersExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsersExportRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Description: `
+Data source to export the users of a tenant, or of one of its connections. An export job is started every time the
+data source is read, the gzipped result is downloaded to a local file once the job completes.`,
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the connection to export the users of. All users are exported when not set",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "csv",
+				ValidateFunc: validation.StringInSlice([]string{"json", "csv"}, false),
+				Description:  "Format of the export. Options include `json` and `csv`",
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Fields to export. A predefined set of fields is exported when not set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the user attribute, e.g. `email` or `user_metadata.plan`",
+						},
+						"export_as": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the field in the export",
+						},
+					},
+				},
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Path of the file the gzipped export is written to. Defaults to a file named after " +
+					"the job in the temporary directory",
+			},
+			"rows": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users exported",
+			},
+		},
+	}
+}
+
+func dataSourceUsersExportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	j := expandUsersExportJob(d)
+
+	api := m.(*management.Management)
+	if err := api.Job.ExportUsers(j, management.Context(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(j.GetID())
+
+	log.Printf("[INFO] Waiting for the users export job (%s) to complete", d.Id())
+	v, err := usersExportJobStateConf(ctx, d, api).WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	j = v.(*management.Job)
+
+	path := d.Get("output_path").(string)
+	if path == "" {
+		path = filepath.Join(os.TempDir(), fmt.Sprintf("auth0-users-export-%s.%s.gz", d.Id(), j.GetFormat()))
+	}
+	if err := downloadUsersExport(ctx, j.GetLocation(), path); err != nil {
+		return diag.FromErr(err)
+	}
+
+	rows, err := countUsersExportRows(path, j.GetFormat())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("output_path", path)
+	_ = d.Set("rows", rows)
+	return nil
+}
+
+func expandUsersExportJob(d ResourceData) *management.Job {
+	j := &management.Job{
+		ConnectionID: String(d, "connection_id"),
+		Format:       String(d, "format"),
+	}
+
+	List(d, "fields").Elem(func(d ResourceData) {
+		field := map[string]interface{}{"name": d.Get("name")}
+		if exportAs := String(d, "export_as"); exportAs != nil {
+			field["export_as"] = *exportAs
+		}
+		j.Fields = append(j.Fields, field)
+	})
+
+	return j
+}
+
+func usersExportJobStateConf(ctx context.Context, d *schema.ResourceData, api *management.Management) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending: []string{"pending", "processing"},
+		Target:  []string{"completed"},
+		Refresh: func() (interface{}, string, error) {
+			j, err := api.Job.Read(d.Id(), management.Context(ctx))
+			if err != nil {
+				return nil, "", err
+			}
+			if j.GetStatus() == "failed" {
+				return j, "failed", fmt.Errorf("users export job %s failed", d.Id())
+			}
+			return j, j.GetStatus(), nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutRead),
+		MinTimeout: 5 * time.Second,
+	}
+}
+
+// downloadUsersExport writes the gzipped export found at location to path.
+func downloadUsersExport(ctx context.Context, location, path string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", location, nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download the users export: %s", res.Status)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, res.Body); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// countUsersExportRows counts the users in a gzipped export. JSON exports
+// hold one user per line, CSV exports start with a header line.
+func countUsersExportRows(path, format string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	var rows int
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for s.Scan() {
+		if len(s.Bytes()) > 0 {
+			rows++
+		}
+	}
+	if err := s.Err(); err != nil {
+		return 0, err
+	}
+
+	if format == "csv" && rows > 0 {
+		rows--
+	}
+	return rows, nil
+}
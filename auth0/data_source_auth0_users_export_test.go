@@ -0,0 +1,144 @@
+package auth0
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceUsersExport(t *testing.T) {
+	rand := random.String(6)
+
+	dir, err := ioutil.TempDir("", "auth0-users-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.TemplateMap(`
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Export-{{.random}}"
+	strategy = "auth0"
+}
+
+resource "auth0_user" "my_user" {
+	connection_name = auth0_connection.my_connection.name
+	email = "export-{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+}
+
+data "auth0_users_export" "my_export" {
+	connection_id = auth0_connection.my_connection.id
+	format = "json"
+	output_path = "{{.path}}"
+
+	fields {
+		name = "email"
+	}
+	fields {
+		name = "user_id"
+		export_as = "id"
+	}
+
+	depends_on = [ auth0_user.my_user ]
+}
+`, map[string]string{"random": rand, "path": filepath.Join(dir, "users.json.gz")}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.auth0_users_export.my_export", "output_path", filepath.Join(dir, "users.json.gz")),
+					resource.TestCheckResourceAttr("data.auth0_users_export.my_export", "rows", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandUsersExportJob(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceUsersExport().Schema, map[string]interface{}{
+		"connection_id": "con_123",
+		"fields": []interface{}{
+			map[string]interface{}{"name": "email"},
+			map[string]interface{}{"name": "user_id", "export_as": "id"},
+		},
+	})
+
+	j := expandUsersExportJob(d)
+	if j.GetConnectionID() != "con_123" || j.GetFormat() != "csv" {
+		t.Errorf("Unexpected job %v", j)
+	}
+	expected := []map[string]interface{}{
+		{"name": "email"},
+		{"name": "user_id", "export_as": "id"},
+	}
+	if !reflect.DeepEqual(j.Fields, expected) {
+		t.Errorf("Expected the fields %v, got %v", expected, j.Fields)
+	}
+}
+
+func TestDownloadUsersExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth0-users-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	gz := func(s string) []byte {
+		var b bytes.Buffer
+		w := gzip.NewWriter(&b)
+		_, _ = w.Write([]byte(s))
+		_ = w.Close()
+		return b.Bytes()
+	}
+
+	for _, tc := range []struct {
+		format  string
+		content string
+		rows    int
+	}{
+		{"json", "{\"email\":\"a@example.com\"}\n{\"email\":\"b@example.com\"}\n", 2},
+		{"csv", "email,id\na@example.com,1\nb@example.com,2\nc@example.com,3\n", 3},
+		{"csv", "email,id\n", 0},
+	} {
+		body := gz(tc.content)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(body)
+		}))
+
+		path := filepath.Join(dir, "users."+tc.format+".gz")
+		if err := downloadUsersExport(context.Background(), server.URL, path); err != nil {
+			t.Fatal(err)
+		}
+		server.Close()
+
+		rows, err := countUsersExportRows(path, tc.format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rows != tc.rows {
+			t.Errorf("Expected %d %s rows, got %d", tc.rows, tc.format, rows)
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	if err := downloadUsersExport(context.Background(), server.URL, filepath.Join(dir, "forbidden.gz")); err == nil {
+		t.Errorf("Expected an error when the download fails")
+	}
+}
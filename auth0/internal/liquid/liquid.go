@@ -0,0 +1,200 @@
+// Package liquid implements a minimal parser of the Liquid template language,
+// good enough to validate the templates we send to Auth0 at plan time.
+package liquid
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Kind is the kind of a template token.
+type Kind int
+
+const (
+	// Text is a token of literal text.
+	Text Kind = iota
+	// Tag is a `{% ... %}` token.
+	Tag
+	// Output is a `{{ ... }}` token.
+	Output
+)
+
+// Token is a single element of a Liquid template.
+type Token struct {
+	Kind Kind
+
+	// Name is the name of the tag, such as `if` or `endfor`. It is empty for
+	// Text and Output tokens.
+	Name string
+
+	// Value holds the literal text of a Text token, the arguments of a Tag
+	// token or the expression of an Output token.
+	Value string
+
+	// Line is the line at which the token starts.
+	Line int
+}
+
+// blocks maps the tags opening a block to the tag closing it.
+var blocks = map[string]string{
+	"if":       "endif",
+	"unless":   "endunless",
+	"case":     "endcase",
+	"for":      "endfor",
+	"tablerow": "endtablerow",
+	"capture":  "endcapture",
+	"comment":  "endcomment",
+	"raw":      "endraw",
+}
+
+// branches maps the tags that can only appear inside a block to the blocks
+// allowing them.
+var branches = map[string][]string{
+	"else":     {"if", "unless", "case", "for"},
+	"elsif":    {"if", "unless"},
+	"when":     {"case"},
+	"break":    {"for", "tablerow"},
+	"continue": {"for", "tablerow"},
+}
+
+// Tokenize splits a template into its tokens. It fails if a tag or an output
+// is not terminated.
+func Tokenize(src string) ([]Token, error) {
+	var tokens []Token
+	line := 1
+	for len(src) > 0 {
+		start := indexOfDelimiter(src)
+		if start < 0 {
+			tokens = append(tokens, Token{Kind: Text, Value: src, Line: line})
+			break
+		}
+		if start > 0 {
+			tokens = append(tokens, Token{Kind: Text, Value: src[:start], Line: line})
+			line += strings.Count(src[:start], "\n")
+			src = src[start:]
+		}
+
+		closing := "%}"
+		kind := Tag
+		if src[1] == '{' {
+			closing, kind = "}}", Output
+		}
+		end := strings.Index(src[2:], closing)
+		if end < 0 {
+			return nil, fmt.Errorf("line %d: %q is not terminated by %q", line, src[:2], closing)
+		}
+		content := src[2 : end+2]
+		raw := src[:end+4]
+		src = src[end+4:]
+
+		content = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(content, "-"), "-"))
+		token := Token{Kind: kind, Value: content, Line: line}
+		if kind == Tag {
+			fields := strings.Fields(content)
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("line %d: empty tag", line)
+			}
+			token.Name = fields[0]
+			token.Value = strings.TrimSpace(strings.TrimPrefix(content, fields[0]))
+		}
+		line += strings.Count(raw, "\n")
+
+		tokens = append(tokens, token)
+
+		// The content of raw and comment blocks is not interpreted, so we
+		// keep it as text until the block is closed.
+		if kind == Tag && (token.Name == "raw" || token.Name == "comment") {
+			loc := endTagRegexp(blocks[token.Name]).FindStringIndex(src)
+			if loc == nil {
+				return nil, fmt.Errorf("line %d: %q is never closed by %q", token.Line, token.Name, blocks[token.Name])
+			}
+			if loc[0] > 0 {
+				tokens = append(tokens, Token{Kind: Text, Value: src[:loc[0]], Line: line})
+				line += strings.Count(src[:loc[0]], "\n")
+			}
+			tokens = append(tokens, Token{Kind: Tag, Name: blocks[token.Name], Line: line})
+			src = src[loc[1]:]
+		}
+	}
+	return tokens, nil
+}
+
+// Validate checks that the template can be tokenized and that all its blocks
+// are balanced.
+func Validate(src string) error {
+	tokens, err := Tokenize(src)
+	if err != nil {
+		return err
+	}
+	return validateBlocks(tokens)
+}
+
+func validateBlocks(tokens []Token) error {
+	var stack []Token
+	for _, t := range tokens {
+		if t.Kind != Tag {
+			continue
+		}
+		if _, ok := blocks[t.Name]; ok {
+			stack = append(stack, t)
+			continue
+		}
+		if allowed, ok := branches[t.Name]; ok {
+			if !insideAny(stack, allowed) {
+				return fmt.Errorf("line %d: %q is not allowed outside of %s", t.Line, t.Name, strings.Join(allowed, ", "))
+			}
+			continue
+		}
+		if strings.HasPrefix(t.Name, "end") {
+			if len(stack) == 0 {
+				return fmt.Errorf("line %d: unexpected %q", t.Line, t.Name)
+			}
+			open := stack[len(stack)-1]
+			if blocks[open.Name] != t.Name {
+				return fmt.Errorf("line %d: unexpected %q, expected %q to close %q opened at line %d",
+					t.Line, t.Name, blocks[open.Name], open.Name, open.Line)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if len(stack) > 0 {
+		open := stack[len(stack)-1]
+		return fmt.Errorf("line %d: %q is never closed by %q", open.Line, open.Name, blocks[open.Name])
+	}
+	return nil
+}
+
+func insideAny(stack []Token, names []string) bool {
+	for _, t := range stack {
+		for _, n := range names {
+			if t.Name == n {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasTag reports whether the template contains a tag with the given name.
+func HasTag(tokens []Token, name string) bool {
+	for _, t := range tokens {
+		if t.Kind == Tag && t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// indexOfDelimiter returns the index of the first `{%` or `{{` of src, or -1.
+func indexOfDelimiter(src string) int {
+	tag, output := strings.Index(src, "{%"), strings.Index(src, "{{")
+	if tag < 0 || (output >= 0 && output < tag) {
+		return output
+	}
+	return tag
+}
+
+func endTagRegexp(name string) *regexp.Regexp {
+	return regexp.MustCompile(`{%-?\s*` + name + `\s*-?%}`)
+}
@@ -0,0 +1,86 @@
+package liquid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens, err := Tokenize("<p>Hi {{ user.name }}</p>\n{%- if user.email -%}\n{{user.email}}{% endif %}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []Token{
+		{Kind: Text, Value: "<p>Hi ", Line: 1},
+		{Kind: Output, Value: "user.name", Line: 1},
+		{Kind: Text, Value: "</p>\n", Line: 1},
+		{Kind: Tag, Name: "if", Value: "user.email", Line: 2},
+		{Kind: Text, Value: "\n", Line: 2},
+		{Kind: Output, Value: "user.email", Line: 3},
+		{Kind: Tag, Name: "endif", Line: 3},
+	}
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, got %d: %#v", len(expected), len(tokens), tokens)
+	}
+	for i := range expected {
+		if tokens[i] != expected[i] {
+			t.Errorf("token %d: expected %#v, got %#v", i, expected[i], tokens[i])
+		}
+	}
+}
+
+func TestTokenizeRaw(t *testing.T) {
+	tokens, err := Tokenize("{% raw %}{% if %}{{ x {% endraw %}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tokens) != 3 || tokens[1].Kind != Text || tokens[1].Value != "{% if %}{{ x " || tokens[2].Name != "endraw" {
+		t.Errorf("unexpected tokens: %#v", tokens)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		template string
+		err      string
+	}{
+		{template: "plain text"},
+		{template: "{% if a %}{% elsif b %}{% else %}{% endif %}"},
+		{template: "{% for i in items %}{% if i %}{% break %}{% endif %}{% endfor %}"},
+		{template: "{% case a %}{% when 1 %}{% else %}{% endcase %}"},
+		{template: "{% comment %}{% if {% endcomment %}"},
+		{template: "{% assign a = 1 %}{{ a }}"},
+		{template: "{% if a %}", err: `line 1: "if" is never closed by "endif"`},
+		{template: "{% endif %}", err: `line 1: unexpected "endif"`},
+		{template: "{% if a %}\n{% endfor %}", err: `line 2: unexpected "endfor", expected "endif" to close "if" opened at line 1`},
+		{template: "{% else %}", err: `line 1: "else" is not allowed outside of if, unless, case, for`},
+		{template: "{% when 1 %}", err: `line 1: "when" is not allowed outside of case`},
+		{template: "line\n{{ user.name", err: `line 2: "{{" is not terminated by "}}"`},
+		{template: "{% if a }", err: `line 1: "{%" is not terminated by "%}"`},
+		{template: "{% %}", err: "line 1: empty tag"},
+	} {
+		err := Validate(tc.template)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("Validate(%q) produced an unexpected error: %v", tc.template, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("Validate(%q) expected error %q, got %v", tc.template, tc.err, err)
+		}
+	}
+}
+
+func TestHasTag(t *testing.T) {
+	tokens, err := Tokenize("<head>{%- auth0:head -%}</head><body>{%- auth0:widget -%}</body>")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !HasTag(tokens, "auth0:head") || !HasTag(tokens, "auth0:widget") {
+		t.Errorf("expected both auth0 tags to be found in %#v", tokens)
+	}
+	if HasTag(tokens, "if") {
+		t.Errorf("unexpected if tag found in %#v", tokens)
+	}
+}
@@ -0,0 +1,686 @@
+package liquid
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// node is either a Token, or a *block for the tags having a body.
+type node interface{}
+
+type block struct {
+	open    Token
+	clauses []clause
+}
+
+// clause is a branch of a block, such as the `elsif` of an `if`.
+type clause struct {
+	tag   Token
+	nodes []node
+}
+
+// parse builds the tree of a template, nesting the content of each block
+// into its clauses.
+func parse(src string) ([]node, error) {
+	tokens, err := Tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBlocks(tokens); err != nil {
+		return nil, err
+	}
+	i := 0
+	nodes, _ := parseNodes(tokens, &i, nil)
+	return nodes, nil
+}
+
+func parseNodes(tokens []Token, i *int, stop map[string]bool) ([]node, *Token) {
+	var nodes []node
+	for *i < len(tokens) {
+		t := tokens[*i]
+		*i++
+		if t.Kind == Tag && stop[t.Name] {
+			return nodes, &t
+		}
+		end, ok := blocks[t.Name]
+		if t.Kind != Tag || !ok {
+			nodes = append(nodes, t)
+			continue
+		}
+		b := &block{open: t}
+		stops := map[string]bool{end: true, "else": true, "elsif": true, "when": true}
+		current := clause{tag: t}
+		for {
+			body, next := parseNodes(tokens, i, stops)
+			current.nodes = body
+			b.clauses = append(b.clauses, current)
+			if next == nil || next.Name == end {
+				break
+			}
+			current = clause{tag: *next}
+		}
+		nodes = append(nodes, b)
+	}
+	return nodes, nil
+}
+
+// maxRangeSize is the number of items a range such as `(1..10)` may hold, and
+// maxOutputSize the size of a rendered template, so that rendering a template
+// can't exhaust the memory.
+const (
+	maxRangeSize  = 10000
+	maxOutputSize = 1 << 20
+)
+
+// Render renders the template with the given variables.
+//
+// Only the subset of Liquid used by Auth0 templates is supported: outputs
+// with the common filters, and the if, unless, case, for, assign, capture,
+// raw and comment tags. Tags which are unknown to Liquid, such as the
+// `auth0:head` tag of Universal Login, render as an empty string. Filters
+// which aren't supported leave the value untouched and produce a warning.
+func Render(src string, vars map[string]interface{}) (string, []string, error) {
+	nodes, err := parse(src)
+	if err != nil {
+		return "", nil, err
+	}
+	r := &renderer{vars: map[string]interface{}{}}
+	for k, v := range vars {
+		r.vars[k] = v
+	}
+	var b strings.Builder
+	if err := r.render(&b, nodes); err != nil {
+		return "", nil, err
+	}
+	return b.String(), r.warnings, nil
+}
+
+type renderer struct {
+	vars        map[string]interface{}
+	interrupted string
+	warnings    []string
+}
+
+// warn records a warning once, however often the template triggers it.
+func (r *renderer) warn(warning string) {
+	for _, w := range r.warnings {
+		if w == warning {
+			return
+		}
+	}
+	r.warnings = append(r.warnings, warning)
+}
+
+func (r *renderer) render(b *strings.Builder, nodes []node) error {
+	for _, n := range nodes {
+		if r.interrupted != "" {
+			return nil
+		}
+		var err error
+		switch n := n.(type) {
+		case Token:
+			err = r.renderToken(b, n)
+		case *block:
+			err = r.renderBlock(b, n)
+		}
+		if err != nil {
+			return err
+		}
+		if b.Len() > maxOutputSize {
+			return fmt.Errorf("the rendered template is larger than %d bytes", maxOutputSize)
+		}
+	}
+	return nil
+}
+
+func (r *renderer) renderToken(b *strings.Builder, t Token) error {
+	switch t.Kind {
+	case Text:
+		b.WriteString(t.Value)
+	case Output:
+		v, err := r.evalFiltered(t.Value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", t.Line, err)
+		}
+		b.WriteString(toString(v))
+	case Tag:
+		switch t.Name {
+		case "assign":
+			parts := strings.SplitN(t.Value, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("line %d: invalid assign %q", t.Line, t.Value)
+			}
+			v, err := r.evalFiltered(parts[1])
+			if err != nil {
+				return fmt.Errorf("line %d: %w", t.Line, err)
+			}
+			r.vars[strings.TrimSpace(parts[0])] = v
+		case "break", "continue":
+			r.interrupted = t.Name
+		}
+	}
+	return nil
+}
+
+func (r *renderer) renderBlock(b *strings.Builder, bl *block) error {
+	open := bl.open
+	switch open.Name {
+	case "comment":
+		return nil
+	case "raw":
+		for _, n := range bl.clauses[0].nodes {
+			if t, ok := n.(Token); ok {
+				b.WriteString(t.Value)
+			}
+		}
+		return nil
+	case "capture":
+		var captured strings.Builder
+		if err := r.render(&captured, bl.clauses[0].nodes); err != nil {
+			return err
+		}
+		r.vars[strings.TrimSpace(open.Value)] = captured.String()
+		return nil
+	case "if", "unless":
+		for i, c := range bl.clauses {
+			ok := true
+			if c.tag.Name != "else" {
+				var err error
+				if ok, err = r.evalCondition(c.tag.Value); err != nil {
+					return fmt.Errorf("line %d: %w", c.tag.Line, err)
+				}
+				if i == 0 && open.Name == "unless" {
+					ok = !ok
+				}
+			}
+			if ok {
+				return r.render(b, c.nodes)
+			}
+		}
+		return nil
+	case "case":
+		subject, err := r.eval(open.Value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", open.Line, err)
+		}
+		for _, c := range bl.clauses[1:] {
+			if c.tag.Name == "else" {
+				return r.render(b, c.nodes)
+			}
+			for _, candidate := range splitCaseValues(c.tag.Value) {
+				v, err := r.eval(candidate)
+				if err != nil {
+					return fmt.Errorf("line %d: %w", c.tag.Line, err)
+				}
+				if equal(subject, v) {
+					return r.render(b, c.nodes)
+				}
+			}
+		}
+		return nil
+	case "for":
+		return r.renderFor(b, bl)
+	}
+	return fmt.Errorf("line %d: the %q tag is not supported", open.Line, open.Name)
+}
+
+var forRegexp = regexp.MustCompile(`^(\w+)\s+in\s+(\(.*?\)|\S+)(.*)$`)
+
+func (r *renderer) renderFor(b *strings.Builder, bl *block) error {
+	open := bl.open
+	m := forRegexp.FindStringSubmatch(open.Value)
+	if m == nil {
+		return fmt.Errorf("line %d: invalid for loop %q", open.Line, open.Value)
+	}
+	collection, err := r.eval(m[2])
+	if err != nil {
+		return fmt.Errorf("line %d: %w", open.Line, err)
+	}
+	items := toSlice(collection)
+
+	args := strings.Fields(strings.ReplaceAll(m[3], ":", ": "))
+	for i := 0; i < len(args); i++ {
+		switch strings.TrimSuffix(args[i], ":") {
+		case "reversed":
+			reversed := make([]interface{}, len(items))
+			for j := range items {
+				reversed[len(items)-1-j] = items[j]
+			}
+			items = reversed
+		case "offset", "limit":
+			if i+1 >= len(args) {
+				return fmt.Errorf("line %d: missing value for %q", open.Line, args[i])
+			}
+			v, err := r.eval(args[i+1])
+			if err != nil {
+				return fmt.Errorf("line %d: %w", open.Line, err)
+			}
+			n := int(toNumber(v))
+			if n < 0 {
+				n = 0
+			}
+			if strings.HasPrefix(args[i], "offset") {
+				if n > len(items) {
+					n = len(items)
+				}
+				items = items[n:]
+			} else if n < len(items) {
+				items = items[:n]
+			}
+			i++
+		}
+	}
+
+	var elseNodes []node
+	if len(bl.clauses) > 1 {
+		elseNodes = bl.clauses[1].nodes
+	}
+	if len(items) == 0 {
+		return r.render(b, elseNodes)
+	}
+	for i, item := range items {
+		r.vars[m[1]] = item
+		r.vars["forloop"] = map[string]interface{}{
+			"index":   float64(i + 1),
+			"index0":  float64(i),
+			"first":   i == 0,
+			"last":    i == len(items)-1,
+			"length":  float64(len(items)),
+			"rindex":  float64(len(items) - i),
+			"rindex0": float64(len(items) - i - 1),
+		}
+		if err := r.render(b, bl.clauses[0].nodes); err != nil {
+			return err
+		}
+		interrupted := r.interrupted
+		r.interrupted = ""
+		if interrupted == "break" {
+			break
+		}
+	}
+	return nil
+}
+
+func splitCaseValues(s string) []string {
+	var values []string
+	for _, v := range splitOutsideQuotes(s, ",") {
+		values = append(values, splitOutsideQuotes(v, " or ")...)
+	}
+	return values
+}
+
+// evalCondition evaluates the conditions of if, elsif and unless tags. As in
+// Liquid, `and` and `or` have the same precedence and are evaluated from right
+// to left.
+func (r *renderer) evalCondition(expr string) (bool, error) {
+	lexemes := lex(expr)
+	for i := len(lexemes) - 1; i >= 0; i-- {
+		if lexemes[i].kind == lexIdent && (lexemes[i].value == "and" || lexemes[i].value == "or") {
+			left, err := r.evalCondition(joinLexemes(lexemes[:i]))
+			if err != nil {
+				return false, err
+			}
+			right, err := r.evalCondition(joinLexemes(lexemes[i+1:]))
+			if err != nil {
+				return false, err
+			}
+			if lexemes[i].value == "and" {
+				return left && right, nil
+			}
+			return left || right, nil
+		}
+	}
+	return r.evalComparison(lexemes)
+}
+
+func (r *renderer) evalComparison(lexemes []lexeme) (bool, error) {
+	for i, l := range lexemes {
+		if l.kind != lexOperator && !(l.kind == lexIdent && l.value == "contains") {
+			continue
+		}
+		left, err := r.eval(joinLexemes(lexemes[:i]))
+		if err != nil {
+			return false, err
+		}
+		right, err := r.eval(joinLexemes(lexemes[i+1:]))
+		if err != nil {
+			return false, err
+		}
+		return compare(left, l.value, right)
+	}
+	v, err := r.eval(joinLexemes(lexemes))
+	return truthy(v), err
+}
+
+func compare(left interface{}, operator string, right interface{}) (bool, error) {
+	switch operator {
+	case "==":
+		return equal(left, right), nil
+	case "!=", "<>":
+		return !equal(left, right), nil
+	case "contains":
+		switch l := left.(type) {
+		case string:
+			return strings.Contains(l, toString(right)), nil
+		case []interface{}:
+			for _, item := range l {
+				if equal(item, right) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	case "<", ">", "<=", ">=":
+		if left == nil || right == nil {
+			return false, nil
+		}
+		l, r := toNumber(left), toNumber(right)
+		switch operator {
+		case "<":
+			return l < r, nil
+		case ">":
+			return l > r, nil
+		case "<=":
+			return l <= r, nil
+		}
+		return l >= r, nil
+	}
+	return false, fmt.Errorf("unknown operator %q", operator)
+}
+
+// evalFiltered evaluates an expression followed by its filters, such as
+// `user.name | default: "there" | upcase`.
+func (r *renderer) evalFiltered(expr string) (interface{}, error) {
+	parts := splitOutsideQuotes(expr, "|")
+	v, err := r.eval(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range parts[1:] {
+		name, rawArgs := strings.TrimSpace(f), ""
+		if i := strings.Index(name, ":"); i >= 0 {
+			name, rawArgs = strings.TrimSpace(name[:i]), name[i+1:]
+		}
+		var args []interface{}
+		if strings.TrimSpace(rawArgs) != "" {
+			for _, a := range splitOutsideQuotes(rawArgs, ",") {
+				av, err := r.eval(a)
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, av)
+			}
+		}
+		filtered, ok := applyFilter(name, v, args)
+		if !ok {
+			r.warn(fmt.Sprintf("the filter %q is not supported by the preview, the value is left untouched", name))
+			continue
+		}
+		v = filtered
+	}
+	return v, nil
+}
+
+// applyFilter applies the filter to the value, reporting whether the filter is
+// supported.
+func applyFilter(name string, v interface{}, args []interface{}) (interface{}, bool) {
+	arg := func(i int) string {
+		if i < len(args) {
+			return toString(args[i])
+		}
+		return ""
+	}
+	switch name {
+	case "default":
+		if !truthy(v) || toString(v) == "" {
+			if len(args) > 0 {
+				return args[0], true
+			}
+		}
+		return v, true
+	case "upcase":
+		return strings.ToUpper(toString(v)), true
+	case "downcase":
+		return strings.ToLower(toString(v)), true
+	case "capitalize":
+		s := toString(v)
+		if s == "" {
+			return s, true
+		}
+		return strings.ToUpper(s[:1]) + s[1:], true
+	case "escape":
+		return html.EscapeString(toString(v)), true
+	case "strip":
+		return strings.TrimSpace(toString(v)), true
+	case "append":
+		return toString(v) + arg(0), true
+	case "prepend":
+		return arg(0) + toString(v), true
+	case "replace":
+		return strings.ReplaceAll(toString(v), arg(0), arg(1)), true
+	case "size":
+		switch c := v.(type) {
+		case []interface{}:
+			return float64(len(c)), true
+		case map[string]interface{}:
+			return float64(len(c)), true
+		}
+		return float64(len(toString(v))), true
+	}
+	return v, false
+}
+
+var rangeRegexp = regexp.MustCompile(`^\((.+)\.\.(.+)\)$`)
+
+// eval evaluates a single value, which is either a literal or a variable.
+func (r *renderer) eval(expr string) (interface{}, error) {
+	expr = strings.TrimSpace(expr)
+	if m := rangeRegexp.FindStringSubmatch(expr); m != nil {
+		from, err := r.eval(m[1])
+		if err != nil {
+			return nil, err
+		}
+		to, err := r.eval(m[2])
+		if err != nil {
+			return nil, err
+		}
+		if toNumber(to)-toNumber(from) >= maxRangeSize {
+			return nil, fmt.Errorf("the range %s holds more than %d items", expr, maxRangeSize)
+		}
+		var items []interface{}
+		for i := toNumber(from); i <= toNumber(to); i++ {
+			items = append(items, i)
+		}
+		return items, nil
+	}
+	switch expr {
+	case "":
+		return nil, fmt.Errorf("missing value")
+	case "nil", "null":
+		return nil, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "empty", "blank":
+		return "", nil
+	}
+	if expr[0] == '"' || expr[0] == '\'' {
+		if len(expr) < 2 || expr[len(expr)-1] != expr[0] {
+			return nil, fmt.Errorf("unterminated string %s", expr)
+		}
+		return expr[1 : len(expr)-1], nil
+	}
+	if n, err := strconv.ParseFloat(expr, 64); err == nil {
+		return n, nil
+	}
+	if !pathRegexp.MatchString(expr) {
+		return nil, fmt.Errorf("invalid expression %q", expr)
+	}
+	return r.lookup(expr)
+}
+
+var pathSegmentRegexp = regexp.MustCompile(`[^.\[\]]+|\[[^\]]*\]`)
+
+func (r *renderer) lookup(path string) (interface{}, error) {
+	var v interface{} = r.vars
+	for _, segment := range pathSegmentRegexp.FindAllString(path, -1) {
+		key := segment
+		if strings.HasPrefix(segment, "[") {
+			k, err := r.eval(segment[1 : len(segment)-1])
+			if err != nil {
+				return nil, err
+			}
+			if list, ok := v.([]interface{}); ok {
+				i := int(toNumber(k))
+				if i < 0 {
+					i += len(list)
+				}
+				if i < 0 || i >= len(list) {
+					return nil, nil
+				}
+				v = list[i]
+				continue
+			}
+			key = toString(k)
+		}
+		switch c := v.(type) {
+		case map[string]interface{}:
+			v = c[key]
+		case []interface{}:
+			switch key {
+			case "size":
+				v = float64(len(c))
+			case "first":
+				v = nil
+				if len(c) > 0 {
+					v = c[0]
+				}
+			case "last":
+				v = nil
+				if len(c) > 0 {
+					v = c[len(c)-1]
+				}
+			default:
+				v = nil
+			}
+		case string:
+			v = nil
+			if key == "size" {
+				v = float64(len(c))
+			}
+		default:
+			return nil, nil
+		}
+	}
+	return v, nil
+}
+
+func truthy(v interface{}) bool {
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	return v != nil
+}
+
+func equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if isNumber(a) && isNumber(b) {
+		return toNumber(a) == toNumber(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func isNumber(v interface{}) bool {
+	switch v.(type) {
+	case float64, int:
+		return true
+	}
+	return false
+}
+
+func toNumber(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	case string:
+		f, _ := strconv.ParseFloat(n, 64)
+		return f
+	}
+	return 0
+}
+
+func toSlice(v interface{}) []interface{} {
+	switch c := v.(type) {
+	case []interface{}:
+		return c
+	case map[string]interface{}:
+		keys := make([]string, 0, len(c))
+		for k := range c {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		items := make([]interface{}, 0, len(keys))
+		for _, k := range keys {
+			items = append(items, []interface{}{k, c[k]})
+		}
+		return items
+	case nil:
+		return nil
+	}
+	return []interface{}{v}
+}
+
+func toString(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case float64:
+		if s == math.Trunc(s) && math.Abs(s) < 1e15 {
+			return strconv.FormatInt(int64(s), 10)
+		}
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	case []interface{}:
+		var b strings.Builder
+		for _, item := range s {
+			b.WriteString(toString(item))
+		}
+		return b.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// splitOutsideQuotes splits s around each instance of sep which is not
+// enclosed in quotes.
+func splitOutsideQuotes(s, sep string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '"' || s[i] == '\'':
+			quote = s[i]
+		case strings.HasPrefix(s[i:], sep):
+			parts = append(parts, s[start:i])
+			start = i + len(sep)
+			i += len(sep) - 1
+		}
+	}
+	return append(parts, s[start:])
+}
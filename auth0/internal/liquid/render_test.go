@@ -0,0 +1,112 @@
+package liquid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]interface{}{
+		"user": map[string]interface{}{
+			"name":           "John Doe",
+			"email":          "john.doe@example.com",
+			"email_verified": false,
+			"user_metadata":  map[string]interface{}{"lang": "fr"},
+		},
+		"application": map[string]interface{}{"name": "My App"},
+		"url":         "https://example.com/verify?ticket=abc",
+		"items":       []interface{}{"a", "b", "c"},
+		"count":       float64(3),
+	}
+	for _, tc := range []struct {
+		template string
+		expected string
+	}{
+		{template: "Hello {{ user.name }}!", expected: "Hello John Doe!"},
+		{template: "{{ user.nickname | default: 'there' | upcase }}", expected: "THERE"},
+		{template: "{{ user.name | downcase | replace: ' ', '-' | append: '.' }}", expected: "john-doe."},
+		{template: "{{ user['email'] }}", expected: "john.doe@example.com"},
+		{template: "{{ '<b>' | escape }}", expected: "&lt;b&gt;"},
+		{template: "{{ items.size }} {{ items | size }} {{ items.first }}{{ items.last }} {{ items[1] }}", expected: "3 3 ac b"},
+		{template: "{{ count }} {{ 1.5 }}", expected: "3 1.5"},
+		{template: "{% if user.email_verified %}yes{% else %}no{% endif %}", expected: "no"},
+		{template: "{% unless user.email_verified %}verify{% endunless %}", expected: "verify"},
+		{template: "{% if user.user_metadata.lang == 'fr' %}Bonjour{% elsif user.user_metadata.lang == 'es' %}Hola{% else %}Hi{% endif %}", expected: "Bonjour"},
+		{template: "{% if count > 2 and user.name contains 'John' %}ok{% endif %}", expected: "ok"},
+		{template: "{% if count < 2 or items contains 'b' %}ok{% endif %}", expected: "ok"},
+		{template: "{% if missing %}no{% endif %}", expected: ""},
+		{template: "{% case user.user_metadata.lang %}{% when 'es', 'fr' %}latin{% else %}other{% endcase %}", expected: "latin"},
+		{template: "{% for i in items %}{{ forloop.index }}{{ i }}{% unless forloop.last %},{% endunless %}{% endfor %}", expected: "1a,2b,3c"},
+		{template: "{% for i in items reversed limit: 2 %}{{ i }}{% endfor %}", expected: "cb"},
+		{template: "{% for i in items offset: 1 %}{{ i }}{% endfor %}", expected: "bc"},
+		{template: "{% for i in (1..count) %}{{ i }}{% if i == 2 %}{% break %}{% endif %}{% endfor %}", expected: "12"},
+		{template: "{% for i in missing %}{{ i }}{% else %}empty{% endfor %}", expected: "empty"},
+		{template: "{% assign greeting = 'Hi ' | append: user.name %}{{ greeting }}", expected: "Hi John Doe"},
+		{template: "{% capture link %}<a href=\"{{ url }}\">{{ application.name }}</a>{% endcapture %}{{ link }}", expected: "<a href=\"https://example.com/verify?ticket=abc\">My App</a>"},
+		{template: "{% raw %}{{ user.name }}{% endraw %}", expected: "{{ user.name }}"},
+		{template: "a{% comment %}{{ user.name }}{% endcomment %}b", expected: "ab"},
+		{template: "<head>{%- auth0:head -%}</head>", expected: "<head></head>"},
+	} {
+		out, warnings, err := Render(tc.template, vars)
+		if err != nil {
+			t.Errorf("Render(%q) produced an unexpected error: %v", tc.template, err)
+			continue
+		}
+		if out != tc.expected {
+			t.Errorf("Render(%q): expected %q, got %q", tc.template, tc.expected, out)
+		}
+		if len(warnings) > 0 {
+			t.Errorf("Render(%q) produced unexpected warnings: %v", tc.template, warnings)
+		}
+	}
+}
+
+func TestRenderUnsupportedFilter(t *testing.T) {
+	out, warnings, err := Render("{{ name | url_encode | upcase }} {{ name | url_encode }}", map[string]interface{}{"name": "john doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "JOHN DOE john doe" {
+		t.Errorf("expected the unsupported filter to leave the value untouched, got %q", out)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `"url_encode"`) {
+		t.Errorf("expected a single warning about url_encode, got %v", warnings)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	for _, tc := range []struct {
+		template string
+		err      string
+	}{
+		{template: "{% for i in (1..1000000000) %}{{ i }}{% endfor %}", err: `the range (1..1000000000) holds more than 10000 items`},
+		{template: "{% for i in (1..9999) %}{% for j in (1..9999) %}{{ j }}{% endfor %}{% endfor %}", err: `the rendered template is larger than`},
+		{template: "{% if a %}", err: `"if" is never closed`},
+		{template: "{% tablerow i in items %}{% endtablerow %}", err: `the "tablerow" tag is not supported`},
+		{template: "\n{% for i %}{% endfor %}", err: `line 2: invalid for loop "i"`},
+	} {
+		_, _, err := Render(tc.template, nil)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("Render(%q): expected error %q, got %v", tc.template, tc.err, err)
+		}
+	}
+}
+
+func TestVariables(t *testing.T) {
+	variables, err := Variables(`
+{% assign name = user.name | default: friendly_name %}
+{% capture link %}<a href="{{ url }}">{{ application.name }}</a>{% endcapture %}
+Hello {{ name }}, {{ link }}
+{% if user.email_verified and tenant == "acme" %}{% endif %}
+{% for item in user.app_metadata.items limit: max %}{{ item }}{{ forloop.index }}{% endfor %}
+{{ "literal" | append: suffix }}
+{% raw %}{{ ignored }}{% endraw %}
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"application", "friendly_name", "max", "suffix", "tenant", "url", "user"}
+	if strings.Join(variables, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v, got %v", expected, variables)
+	}
+}
@@ -0,0 +1,136 @@
+package liquid
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type lexemeKind int
+
+const (
+	lexString lexemeKind = iota
+	lexNumber
+	lexIdent
+	lexOperator
+	lexPunctuation
+)
+
+type lexeme struct {
+	kind  lexemeKind
+	value string
+}
+
+var (
+	pathRegexp   = regexp.MustCompile(`^[A-Za-z_][\w-]*(\.[\w-]+|\[[^\]]*\])*$`)
+	lexemeRegexp = regexp.MustCompile(`"[^"]*"|'[^']*'|-?\d+(\.\d+)?|[A-Za-z_][\w-]*(\.[\w-]+|\[[^\]]*\])*\??|==|!=|<>|<=|>=|<|>|\.\.|\S`)
+	numberRegexp = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+)
+
+// keywords are the identifiers of the language which are not variables.
+var keywords = map[string]bool{
+	"and": true, "or": true, "contains": true, "in": true,
+	"nil": true, "null": true, "true": true, "false": true, "empty": true, "blank": true,
+	"reversed": true, "limit": true, "offset": true,
+}
+
+func lex(expr string) []lexeme {
+	var lexemes []lexeme
+	for _, v := range lexemeRegexp.FindAllString(expr, -1) {
+		l := lexeme{kind: lexPunctuation, value: v}
+		switch {
+		case v[0] == '"' || v[0] == '\'':
+			l.kind = lexString
+		case numberRegexp.MatchString(v):
+			l.kind = lexNumber
+		case pathRegexp.MatchString(strings.TrimSuffix(v, "?")):
+			l.kind = lexIdent
+		case v == "==" || v == "!=" || v == "<>" || v == "<=" || v == ">=" || v == "<" || v == ">":
+			l.kind = lexOperator
+		}
+		lexemes = append(lexemes, l)
+	}
+	return lexemes
+}
+
+func joinLexemes(lexemes []lexeme) string {
+	values := make([]string, 0, len(lexemes))
+	for _, l := range lexemes {
+		values = append(values, l.value)
+	}
+	return strings.Join(values, " ")
+}
+
+// Variables returns the sorted names of the variables a template expects to
+// be given, leaving out those which the template defines itself through the
+// assign, capture and for tags.
+func Variables(src string) ([]string, error) {
+	tokens, err := Tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]bool{}
+	defined := map[string]bool{"forloop": true}
+	for _, t := range tokens {
+		switch {
+		case t.Kind == Output:
+			filteredRoots(t.Value, used)
+		case t.Kind != Tag:
+			continue
+		case t.Name == "if" || t.Name == "elsif" || t.Name == "unless" || t.Name == "case" || t.Name == "when":
+			roots(lex(t.Value), used)
+		case t.Name == "assign":
+			parts := strings.SplitN(t.Value, "=", 2)
+			defined[strings.TrimSpace(parts[0])] = true
+			if len(parts) == 2 {
+				filteredRoots(parts[1], used)
+			}
+		case t.Name == "capture":
+			defined[strings.TrimSpace(t.Value)] = true
+		case t.Name == "for":
+			if m := forRegexp.FindStringSubmatch(t.Value); m != nil {
+				defined[m[1]] = true
+				roots(lex(m[2]+" "+m[3]), used)
+			}
+		}
+	}
+
+	var variables []string
+	for name := range used {
+		if !defined[name] {
+			variables = append(variables, name)
+		}
+	}
+	sort.Strings(variables)
+	return variables, nil
+}
+
+// filteredRoots collects the variables of an expression followed by filters.
+// The filter names are skipped, but not their arguments.
+func filteredRoots(expr string, used map[string]bool) {
+	parts := splitOutsideQuotes(expr, "|")
+	roots(lex(parts[0]), used)
+	for _, f := range parts[1:] {
+		if i := strings.Index(f, ":"); i >= 0 {
+			roots(lex(f[i+1:]), used)
+		}
+	}
+}
+
+func roots(lexemes []lexeme, used map[string]bool) {
+	for i, l := range lexemes {
+		if l.kind != lexIdent || keywords[l.value] {
+			continue
+		}
+		// named arguments, such as `limit: 2`
+		if i+1 < len(lexemes) && lexemes[i+1].value == ":" {
+			continue
+		}
+		root := l.value
+		if j := strings.IndexAny(root, ".[?"); j >= 0 {
+			root = root[:j]
+		}
+		used[root] = true
+	}
+}
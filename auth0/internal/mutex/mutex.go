@@ -0,0 +1,44 @@
+package mutex
+
+import (
+	"log"
+	"sync"
+)
+
+// KV is a set of mutexes identified by a key. It allows the resources which
+// read, modify and write a shared Auth0 object, such as the list of credentials
+// of a client, to be applied concurrently without overwriting each other.
+type KV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// New returns an empty KV.
+func New() *KV {
+	return &KV{store: map[string]*sync.Mutex{}}
+}
+
+// Lock locks the mutex of the given key, creating it if necessary.
+func (m *KV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock unlocks the mutex of the given key.
+func (m *KV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+func (m *KV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
@@ -0,0 +1,29 @@
+package mutex
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestKV(t *testing.T) {
+	m := New()
+	counters := map[string]*int{"a": new(int), "b": new(int)}
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		for _, key := range []string{"a", "b"} {
+			wg.Add(1)
+			go func(key string) {
+				defer wg.Done()
+				m.Lock(key)
+				defer m.Unlock(key)
+				*counters[key]++
+			}(key)
+		}
+	}
+	wg.Wait()
+	for _, key := range []string{"a", "b"} {
+		if *counters[key] != 100 {
+			t.Errorf("expected counter %q to be 100, got %d", key, *counters[key])
+		}
+	}
+}
@@ -3,8 +3,11 @@ package validation
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 )
 
+var hexColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
 // IsURLWithNoFragment is a SchemaValidateFunc which tests if the provided value
 // is of type string and a valid URL with no fragment.
 func IsURLWithNoFragment(i interface{}, k string) (warnings []string, errors []error) {
@@ -37,3 +40,20 @@ func IsURLWithNoFragment(i interface{}, k string) (warnings []string, errors []e
 
 	return
 }
+
+// IsHexColor is a SchemaValidateFunc which tests if the provided value is of
+// type string and a hexadecimal color, such as #fff or #ffffff.
+func IsHexColor(i interface{}, k string) (warnings []string, errors []error) {
+
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !hexColorRegexp.MatchString(v) {
+		errors = append(errors, fmt.Errorf("expected %q to be a hexadecimal color such as #ffffff, got %v", k, v))
+	}
+
+	return
+}
@@ -16,3 +16,24 @@ func TestIsURLWithNoFragment(t *testing.T) {
 		}
 	}
 }
+
+func TestIsHexColor(t *testing.T) {
+	for color, valid := range map[string]bool{
+		"#fff":     true,
+		"#FFFFFF":  true,
+		"#635dff":  true,
+		"fff":      false,
+		"#ffff":    false,
+		"#gggggg":  false,
+		"#ffffff0": false,
+		"":         false,
+	} {
+		_, err := IsHexColor(color, "color")
+		if len(err) > 0 && valid {
+			t.Errorf("IsHexColor(%s) produced an unexpected error", color)
+		}
+		if len(err) == 0 && !valid {
+			t.Errorf("IsHexColor(%s) should have produced an error", color)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/mutex"
 	"github.com/alekc/terraform-provider-auth0/version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,10 @@ import (
 
 var provider *schema.Provider
 
+// globalMutex serializes the changes made by different resources to the same
+// Auth0 object.
+var globalMutex = mutex.New()
+
 func init() {
 	provider = &schema.Provider{
 		Schema: map[string]*schema.Schema{
@@ -47,32 +52,46 @@ func init() {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"auth0_client":          newClient(),
-			"auth0_client_grant":    newClientGrant(),
-			"auth0_connection":      newConnection(),
-			"auth0_custom_domain":   newCustomDomain(),
-			"auth0_resource_server": newResourceServer(),
-			"auth0_rule":            newRule(),
-			"auth0_rule_config":     newRuleConfig(),
-			"auth0_hook":            newHook(),
-			"auth0_prompt":          newPrompt(),
-			"auth0_email":           newEmail(),
-			"auth0_email_template":  newEmailTemplate(),
-			"auth0_user":            newUser(),
-			"auth0_tenant":          newTenant(),
-			"auth0_role":            newRole(),
-			"auth0_log_stream":      newLogStream(),
-			"auth0_branding":        newBranding(),
-			"auth0_guardian":        newGuardian(),
-			"auth0_action":          newAction(),
-			"auth0_flow":            newFlow(),
+			"auth0_client":                newClient(),
+			"auth0_client_credential":     newClientCredential(),
+			"auth0_client_grant":          newClientGrant(),
+			"auth0_connection":            newConnection(),
+			"auth0_custom_domain":         newCustomDomain(),
+			"auth0_resource_server":       newResourceServer(),
+			"auth0_resource_server_scope": newResourceServerScope(),
+			"auth0_rule":                  newRule(),
+			"auth0_rule_config":           newRuleConfig(),
+			"auth0_rule_order":            newRuleOrder(),
+			"auth0_hook":                  newHook(),
+			"auth0_prompt":                newPrompt(),
+			"auth0_prompt_custom_text":    newPromptCustomText(),
+			"auth0_email":                 newEmail(),
+			"auth0_email_template":        newEmailTemplate(),
+			"auth0_user":                  newUser(),
+			"auth0_user_identity_link":    newUserIdentityLink(),
+			"auth0_user_permission":       newUserPermission(),
+			"auth0_user_unblock":          newUserUnblock(),
+			"auth0_user_mfa_reset":        newUserMFAReset(),
+			"auth0_tenant":                newTenant(),
+			"auth0_role":                  newRole(),
+			"auth0_log_stream":            newLogStream(),
+			"auth0_branding":              newBranding(),
+			"auth0_branding_theme":        newBrandingTheme(),
+			"auth0_guardian":              newGuardian(),
+			"auth0_action":                newAction(),
+			"auth0_flow":                  newFlow(),
+			"auth0_users_import_job":      newUsersImportJob(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"auth0_client":          dataSourceAuth0Client(),
-			"auth0_connection":      dataSourceConnection(),
-			"auth0_custom_domain":   dataSourceCustomDomain(),
-			"auth0_resource_server": dataSourceResourceServer(),
-			"auth0_role":            dataSourceRole(),
+			"auth0_branding_theme":         dataSourceBrandingTheme(),
+			"auth0_client":                 dataSourceAuth0Client(),
+			"auth0_client_grant":           dataSourceClientGrant(),
+			"auth0_connection":             dataSourceConnection(),
+			"auth0_custom_domain":          dataSourceCustomDomain(),
+			"auth0_email_template_preview": dataSourceEmailTemplatePreview(),
+			"auth0_resource_server":        dataSourceResourceServer(),
+			"auth0_role":                   dataSourceRole(),
+			"auth0_users_export":           dataSourceUsersExport(),
 		},
 		ConfigureContextFunc: Configure,
 	}
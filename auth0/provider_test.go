@@ -1,10 +1,12 @@
 package auth0
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"gopkg.in/auth0.v5/management"
 )
 
@@ -85,3 +87,21 @@ func TestProvider_debugDefaults(t *testing.T) {
 		}
 	}
 }
+
+// testResourceDataUpdate returns the data of an update of the resource with
+// the given state attributes to the raw configuration.
+func testResourceDataUpdate(t *testing.T, s map[string]*schema.Schema, state map[string]string, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	m := schema.InternalMap(s)
+	is := &terraform.InstanceState{ID: "id", Attributes: state}
+	diff, err := m.Diff(context.Background(), is, terraform.NewResourceConfigRaw(raw), nil, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := m.Data(is, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
@@ -2,14 +2,20 @@ package auth0
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/liquid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/management"
 )
 
@@ -82,10 +88,21 @@ This resource allows you to manage a branding within your Auth0 tenant.`,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"body": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "body of login pages",
-							Computed:    true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Description:   "body of login pages",
+							Computed:      true,
+							ValidateFunc:  validateUniversalLoginBody,
+							ConflictsWith: []string{"universal_login.0.body_file"},
+						},
+						"body_file": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Path to a file containing the body of login pages. Only the SHA256 hash " +
+								"of the file content is stored in the state",
+							ValidateFunc:  validateUniversalLoginBodyFile,
+							StateFunc:     universalLoginBodyFileHash,
+							ConflictsWith: []string{"universal_login.0.body"},
 						},
 					},
 				},
@@ -129,9 +146,12 @@ func readBranding(ctx context.Context, d *schema.ResourceData, m interface{}) di
 
 func updateBranding(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	branding := buildBranding(d)
-	universalLogin := buildBrandingUniversalLogin(d)
+	universalLogin, err := buildBrandingUniversalLogin(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	api := m.(*management.Management)
-	err := api.Branding.Update(branding, management.Context(ctx))
+	err = api.Branding.Update(branding, management.Context(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -183,14 +203,23 @@ func buildBranding(d *schema.ResourceData) *management.Branding {
 	return b
 }
 
-func buildBrandingUniversalLogin(d *schema.ResourceData) *management.BrandingUniversalLogin {
-	b := &management.BrandingUniversalLogin{}
+func buildBrandingUniversalLogin(d *schema.ResourceData) (b *management.BrandingUniversalLogin, err error) {
+	b = &management.BrandingUniversalLogin{}
 
 	List(d, "universal_login").Elem(func(d ResourceData) {
 		b.Body = String(d, "body")
+
+		// The state only holds the hash of the file, so its content can only
+		// be read when the path comes from the configuration, which is the
+		// case whenever the hash changed.
+		if path := String(d, "body_file", HasChange()); path != nil {
+			var body []byte
+			body, err = ioutil.ReadFile(*path)
+			b.Body = auth0.String(string(body))
+		}
 	})
 
-	return b
+	return b, err
 }
 
 func assignUniversalLogin(ctx context.Context, d *schema.ResourceData, m interface{}) error {
@@ -208,6 +237,13 @@ func assignUniversalLogin(ctx context.Context, d *schema.ResourceData, m interfa
 		return err
 	}
 
+	if _, ok := d.GetOk("universal_login.0.body_file"); ok {
+		_ = d.Set("universal_login", []interface{}{
+			map[string]interface{}{"body_file": hashUniversalLoginBody(ul.GetBody())},
+		})
+		return nil
+	}
+
 	_ = d.Set("universal_login", flattenBrandingUniversalLogin(ul))
 	return nil
 }
@@ -236,3 +272,67 @@ func flattenBrandingFont(brandingFont *management.BrandingFont) []interface{} {
 	}
 	return []interface{}{m}
 }
+
+// universalLoginBodyMaxSize is the maximum size of a Universal Login template
+// accepted by Auth0.
+const universalLoginBodyMaxSize = 100 * 1024
+
+// validateUniversalLoginBody checks that a Universal Login template contains
+// the tags required by Auth0, that its Liquid blocks are balanced and that it
+// isn't too large.
+func validateUniversalLoginBody(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if len(v) > universalLoginBodyMaxSize {
+		errs = append(errs, fmt.Errorf("expected %q to be at most %d bytes, got %d", k, universalLoginBodyMaxSize, len(v)))
+	}
+
+	tokens, err := liquid.Tokenize(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid liquid template: %w", k, err))
+		return
+	}
+	if err := liquid.Validate(v); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid liquid template: %w", k, err))
+	}
+	for _, tag := range []string{"auth0:head", "auth0:widget"} {
+		if !liquid.HasTag(tokens, tag) {
+			errs = append(errs, fmt.Errorf("%q must contain the {%%- %s -%%} tag", k, tag))
+		}
+	}
+	return
+}
+
+// validateUniversalLoginBodyFile applies validateUniversalLoginBody to the
+// content of the file.
+func validateUniversalLoginBodyFile(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	body, err := ioutil.ReadFile(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to read %q: %w", k, err))
+		return
+	}
+	return validateUniversalLoginBody(string(body), k)
+}
+
+func universalLoginBodyFileHash(v interface{}) string {
+	body, err := ioutil.ReadFile(v.(string))
+	if err != nil {
+		return ""
+	}
+	return hashUniversalLoginBody(string(body))
+}
+
+func hashUniversalLoginBody(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
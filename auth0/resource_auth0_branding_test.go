@@ -1,6 +1,10 @@
 package auth0
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -57,3 +61,74 @@ resource "auth0_branding" "my_brand" {
 		},
 	})
 }
+
+func TestValidateUniversalLoginBody(t *testing.T) {
+	for _, tc := range []struct {
+		body string
+		errs []string
+	}{
+		{
+			body: "<html><head>{%- auth0:head -%}</head><body>{%- auth0:widget -%}</body></html>",
+		},
+		{
+			body: "<html><head>{%- auth0:head -%}</head><body>{% if prompt.name == \"login\" %}<h1>Hi</h1>{% endif %}{%- auth0:widget -%}</body></html>",
+		},
+		{
+			body: "<html><head></head><body>{%- auth0:widget -%}</body></html>",
+			errs: []string{`"body" must contain the {%- auth0:head -%} tag`},
+		},
+		{
+			body: "<html><head></head><body></body></html>",
+			errs: []string{
+				`"body" must contain the {%- auth0:head -%} tag`,
+				`"body" must contain the {%- auth0:widget -%} tag`,
+			},
+		},
+		{
+			body: "<html><head>{%- auth0:head -%}</head><body>{% if a %}{%- auth0:widget -%}</body></html>",
+			errs: []string{`"body" is not a valid liquid template: line 1: "if" is never closed by "endif"`},
+		},
+		{
+			body: "{%- auth0:head -%}{%- auth0:widget -%}" + strings.Repeat("a", universalLoginBodyMaxSize),
+			errs: []string{`expected "body" to be at most 102400 bytes, got 102438`},
+		},
+	} {
+		_, errs := validateUniversalLoginBody(tc.body, "body")
+		if len(errs) != len(tc.errs) {
+			t.Errorf("expected %d errors, got %v", len(tc.errs), errs)
+			continue
+		}
+		for i := range errs {
+			if errs[i].Error() != tc.errs[i] {
+				t.Errorf("expected error %q, got %q", tc.errs[i], errs[i])
+			}
+		}
+	}
+}
+
+func TestUniversalLoginBodyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth0-branding")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	body := "<html><head>{%- auth0:head -%}</head><body>{%- auth0:widget -%}</body></html>"
+	path := filepath.Join(dir, "login.html")
+	if err := ioutil.WriteFile(path, []byte(body), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, errs := validateUniversalLoginBodyFile(path, "body_file"); len(errs) > 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	if _, errs := validateUniversalLoginBodyFile(filepath.Join(dir, "missing.html"), "body_file"); len(errs) != 1 {
+		t.Errorf("expected an error for a missing file, got %v", errs)
+	}
+	if hash := universalLoginBodyFileHash(path); hash != hashUniversalLoginBody(body) {
+		t.Errorf("expected the hash of the file content, got %s", hash)
+	}
+	if hash := universalLoginBodyFileHash(filepath.Join(dir, "missing.html")); hash != "" {
+		t.Errorf("expected an empty hash for a missing file, got %s", hash)
+	}
+}
@@ -0,0 +1,612 @@
+package auth0
+
+import (
+	"context"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	internalValidation "github.com/alekc/terraform-provider-auth0/auth0/internal/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// brandingTheme mirrors the Universal Login theme model of the management api,
+// which is not covered by the auth0 sdk yet.
+type brandingTheme struct {
+	ID             *string                      `json:"themeId,omitempty"`
+	DisplayName    *string                      `json:"displayName,omitempty"`
+	Borders        *brandingThemeBorders        `json:"borders,omitempty"`
+	Colors         *brandingThemeColors         `json:"colors,omitempty"`
+	Fonts          *brandingThemeFonts          `json:"fonts,omitempty"`
+	PageBackground *brandingThemePageBackground `json:"page_background,omitempty"`
+	Widget         *brandingThemeWidget         `json:"widget,omitempty"`
+}
+
+type brandingThemeBorders struct {
+	ButtonBorderRadius *int    `json:"button_border_radius,omitempty"`
+	ButtonBorderWeight *int    `json:"button_border_weight,omitempty"`
+	ButtonsStyle       *string `json:"buttons_style,omitempty"`
+	InputBorderRadius  *int    `json:"input_border_radius,omitempty"`
+	InputBorderWeight  *int    `json:"input_border_weight,omitempty"`
+	InputsStyle        *string `json:"inputs_style,omitempty"`
+	ShowWidgetShadow   *bool   `json:"show_widget_shadow,omitempty"`
+	WidgetBorderWeight *int    `json:"widget_border_weight,omitempty"`
+	WidgetCornerRadius *int    `json:"widget_corner_radius,omitempty"`
+}
+
+type brandingThemeColors struct {
+	BaseFocusColor          *string `json:"base_focus_color,omitempty"`
+	BaseHoverColor          *string `json:"base_hover_color,omitempty"`
+	BodyText                *string `json:"body_text,omitempty"`
+	CaptchaWidgetTheme      *string `json:"captcha_widget_theme,omitempty"`
+	Error                   *string `json:"error,omitempty"`
+	Header                  *string `json:"header,omitempty"`
+	Icons                   *string `json:"icons,omitempty"`
+	InputBackground         *string `json:"input_background,omitempty"`
+	InputBorder             *string `json:"input_border,omitempty"`
+	InputFilledText         *string `json:"input_filled_text,omitempty"`
+	InputLabelsPlaceholders *string `json:"input_labels_placeholders,omitempty"`
+	LinksFocusedComponents  *string `json:"links_focused_components,omitempty"`
+	PrimaryButton           *string `json:"primary_button,omitempty"`
+	PrimaryButtonLabel      *string `json:"primary_button_label,omitempty"`
+	SecondaryButtonBorder   *string `json:"secondary_button_border,omitempty"`
+	SecondaryButtonLabel    *string `json:"secondary_button_label,omitempty"`
+	Success                 *string `json:"success,omitempty"`
+	WidgetBackground        *string `json:"widget_background,omitempty"`
+	WidgetBorder            *string `json:"widget_border,omitempty"`
+}
+
+type brandingThemeFonts struct {
+	BodyText          *brandingThemeText `json:"body_text,omitempty"`
+	ButtonsText       *brandingThemeText `json:"buttons_text,omitempty"`
+	FontURL           *string            `json:"font_url,omitempty"`
+	InputLabels       *brandingThemeText `json:"input_labels,omitempty"`
+	Links             *brandingThemeText `json:"links,omitempty"`
+	LinksStyle        *string            `json:"links_style,omitempty"`
+	ReferenceTextSize *float64           `json:"reference_text_size,omitempty"`
+	Subtitle          *brandingThemeText `json:"subtitle,omitempty"`
+	Title             *brandingThemeText `json:"title,omitempty"`
+}
+
+type brandingThemeText struct {
+	Bold *bool    `json:"bold,omitempty"`
+	Size *float64 `json:"size,omitempty"`
+}
+
+type brandingThemePageBackground struct {
+	BackgroundColor    *string `json:"background_color,omitempty"`
+	BackgroundImageURL *string `json:"background_image_url,omitempty"`
+	PageLayout         *string `json:"page_layout,omitempty"`
+}
+
+type brandingThemeWidget struct {
+	HeaderTextAlignment *string  `json:"header_text_alignment,omitempty"`
+	LogoHeight          *float64 `json:"logo_height,omitempty"`
+	LogoPosition        *string  `json:"logo_position,omitempty"`
+	LogoURL             *string  `json:"logo_url,omitempty"`
+	SocialButtonsLayout *string  `json:"social_buttons_layout,omitempty"`
+}
+
+func newBrandingTheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createBrandingTheme,
+		ReadContext:   readBrandingTheme,
+		UpdateContext: updateBrandingTheme,
+		DeleteContext: deleteBrandingTheme,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: `With this resource, you can manage the Universal Login theme of your tenant,
+including borders, colors, fonts, page background and widget settings.
+
+Only one theme can exist per tenant.`,
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The display name of the theme",
+			},
+			"borders": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration settings for the borders of the login widget",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"button_border_radius": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							Description:  "Button border radius, in pixels (1-10)",
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+						"button_border_weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Button border weight, in pixels (0-10)",
+							ValidateFunc: validation.IntBetween(0, 10),
+						},
+						"buttons_style": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "rounded",
+							Description:  "Buttons style. Options include `pill`, `rounded` and `sharp`",
+							ValidateFunc: validation.StringInSlice([]string{"pill", "rounded", "sharp"}, false),
+						},
+						"input_border_radius": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							Description:  "Input border radius, in pixels (0-10)",
+							ValidateFunc: validation.IntBetween(0, 10),
+						},
+						"input_border_weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Input border weight, in pixels (0-3)",
+							ValidateFunc: validation.IntBetween(0, 3),
+						},
+						"inputs_style": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "rounded",
+							Description:  "Inputs style. Options include `pill`, `rounded` and `sharp`",
+							ValidateFunc: validation.StringInSlice([]string{"pill", "rounded", "sharp"}, false),
+						},
+						"show_widget_shadow": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether or not to show the widget shadow",
+						},
+						"widget_border_weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							Description:  "Widget border weight, in pixels (0-10)",
+							ValidateFunc: validation.IntBetween(0, 10),
+						},
+						"widget_corner_radius": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							Description:  "Widget corner radius, in pixels (0-50)",
+							ValidateFunc: validation.IntBetween(0, 50),
+						},
+					},
+				},
+			},
+			"colors": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration settings for the colors of the login pages",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_focus_color":          brandingThemeColorSchema("Base focus color", "#635dff"),
+						"base_hover_color":          brandingThemeColorSchema("Base hover color", "#000000"),
+						"body_text":                 brandingThemeColorSchema("Body text", "#1e212a"),
+						"error":                     brandingThemeColorSchema("Error", "#d03c38"),
+						"header":                    brandingThemeColorSchema("Header", "#1e212a"),
+						"icons":                     brandingThemeColorSchema("Icons", "#65676e"),
+						"input_background":          brandingThemeColorSchema("Input background", "#ffffff"),
+						"input_border":              brandingThemeColorSchema("Input border", "#c9cace"),
+						"input_filled_text":         brandingThemeColorSchema("Input filled text", "#000000"),
+						"input_labels_placeholders": brandingThemeColorSchema("Input labels and placeholders", "#65676e"),
+						"links_focused_components":  brandingThemeColorSchema("Links and focused components", "#635dff"),
+						"primary_button":            brandingThemeColorSchema("Primary button", "#635dff"),
+						"primary_button_label":      brandingThemeColorSchema("Primary button label", "#ffffff"),
+						"secondary_button_border":   brandingThemeColorSchema("Secondary button border", "#c9cace"),
+						"secondary_button_label":    brandingThemeColorSchema("Secondary button label", "#1e212a"),
+						"success":                   brandingThemeColorSchema("Success", "#13a688"),
+						"widget_background":         brandingThemeColorSchema("Widget background", "#ffffff"),
+						"widget_border":             brandingThemeColorSchema("Widget border", "#c9cace"),
+						"captcha_widget_theme": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "auto",
+							Description:  "Captcha widget theme. Options include `auto`, `dark` and `light`",
+							ValidateFunc: validation.StringInSlice([]string{"auto", "dark", "light"}, false),
+						},
+					},
+				},
+			},
+			"fonts": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration settings for the fonts of the login pages",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"font_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "URL of the custom font",
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"links_style": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "normal",
+							Description:  "Links style. Options include `normal` and `underlined`",
+							ValidateFunc: validation.StringInSlice([]string{"normal", "underlined"}, false),
+						},
+						"reference_text_size": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      16.0,
+							Description:  "Reference text size, in pixels (12-24)",
+							ValidateFunc: validation.FloatBetween(12, 24),
+						},
+						"body_text":    brandingThemeTextSchema("Body text", false, 87.5, 0),
+						"buttons_text": brandingThemeTextSchema("Buttons text", false, 100, 0),
+						"input_labels": brandingThemeTextSchema("Input labels", false, 100, 0),
+						"links":        brandingThemeTextSchema("Links", true, 87.5, 0),
+						"subtitle":     brandingThemeTextSchema("Subtitle", false, 87.5, 0),
+						"title":        brandingThemeTextSchema("Title", false, 150, 75),
+					},
+				},
+			},
+			"page_background": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration settings for the background of the login pages",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"background_color": brandingThemeColorSchema("Background color", "#000000"),
+						"background_image_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "URL of the background image",
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"page_layout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "center",
+							Description:  "Page layout. Options include `center`, `left` and `right`",
+							ValidateFunc: validation.StringInSlice([]string{"center", "left", "right"}, false),
+						},
+					},
+				},
+			},
+			"widget": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration settings for the login widget",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"header_text_alignment": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "center",
+							Description:  "Header text alignment. Options include `center`, `left` and `right`",
+							ValidateFunc: validation.StringInSlice([]string{"center", "left", "right"}, false),
+						},
+						"logo_height": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      52.0,
+							Description:  "Logo height, in pixels (1-100)",
+							ValidateFunc: validation.FloatBetween(1, 100),
+						},
+						"logo_position": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "center",
+							Description:  "Logo position. Options include `center`, `left`, `right` and `none`",
+							ValidateFunc: validation.StringInSlice([]string{"center", "left", "right", "none"}, false),
+						},
+						"logo_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "URL of the logo",
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"social_buttons_layout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "bottom",
+							Description:  "Social buttons layout. Options include `bottom` and `top`",
+							ValidateFunc: validation.StringInSlice([]string{"bottom", "top"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func brandingThemeColorSchema(description, defaultValue string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      defaultValue,
+		Description:  description + " color, in hexadecimal format",
+		ValidateFunc: internalValidation.IsHexColor,
+	}
+}
+
+func brandingThemeTextSchema(description string, bold bool, size float64, minSize float64) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: description + " font settings",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bold": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     bold,
+					Description: "Whether or not the text is bold",
+				},
+				"size": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      size,
+					Description:  "Text size, as a percentage of the reference text size",
+					ValidateFunc: validation.FloatBetween(minSize, 150),
+				},
+			},
+		},
+	}
+}
+
+func createBrandingTheme(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	t := buildBrandingTheme(d)
+	api := m.(*management.Management)
+	if err := api.Request("POST", api.URI("branding", "themes"), t, management.Context(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(auth0.StringValue(t.ID))
+	return readBrandingTheme(ctx, d, m)
+}
+
+func readBrandingTheme(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	var t *brandingTheme
+	if err := api.Request("GET", api.URI("branding", "themes", d.Id()), &t, management.Context(ctx)); err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	assignBrandingTheme(d, t)
+	return nil
+}
+
+func updateBrandingTheme(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	t := buildBrandingTheme(d)
+	api := m.(*management.Management)
+	if err := api.Request("PATCH", api.URI("branding", "themes", d.Id()), t, management.Context(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+	return readBrandingTheme(ctx, d, m)
+}
+
+func deleteBrandingTheme(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	if err := api.Request("DELETE", api.URI("branding", "themes", d.Id()), nil, management.Context(ctx)); err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	return nil
+}
+
+func assignBrandingTheme(d *schema.ResourceData, t *brandingTheme) {
+	_ = d.Set("display_name", t.DisplayName)
+	_ = d.Set("borders", flattenBrandingThemeBorders(t.Borders))
+	_ = d.Set("colors", flattenBrandingThemeColors(t.Colors))
+	_ = d.Set("fonts", flattenBrandingThemeFonts(t.Fonts))
+	_ = d.Set("page_background", flattenBrandingThemePageBackground(t.PageBackground))
+	_ = d.Set("widget", flattenBrandingThemeWidget(t.Widget))
+}
+
+func buildBrandingTheme(d *schema.ResourceData) *brandingTheme {
+	t := &brandingTheme{
+		DisplayName: String(d, "display_name"),
+	}
+
+	List(d, "borders").Elem(func(d ResourceData) {
+		t.Borders = &brandingThemeBorders{
+			ButtonBorderRadius: auth0.Int(d.Get("button_border_radius").(int)),
+			ButtonBorderWeight: auth0.Int(d.Get("button_border_weight").(int)),
+			ButtonsStyle:       String(d, "buttons_style"),
+			InputBorderRadius:  auth0.Int(d.Get("input_border_radius").(int)),
+			InputBorderWeight:  auth0.Int(d.Get("input_border_weight").(int)),
+			InputsStyle:        String(d, "inputs_style"),
+			ShowWidgetShadow:   Bool(d, "show_widget_shadow"),
+			WidgetBorderWeight: auth0.Int(d.Get("widget_border_weight").(int)),
+			WidgetCornerRadius: auth0.Int(d.Get("widget_corner_radius").(int)),
+		}
+	})
+
+	List(d, "colors").Elem(func(d ResourceData) {
+		t.Colors = &brandingThemeColors{
+			BaseFocusColor:          String(d, "base_focus_color"),
+			BaseHoverColor:          String(d, "base_hover_color"),
+			BodyText:                String(d, "body_text"),
+			CaptchaWidgetTheme:      String(d, "captcha_widget_theme"),
+			Error:                   String(d, "error"),
+			Header:                  String(d, "header"),
+			Icons:                   String(d, "icons"),
+			InputBackground:         String(d, "input_background"),
+			InputBorder:             String(d, "input_border"),
+			InputFilledText:         String(d, "input_filled_text"),
+			InputLabelsPlaceholders: String(d, "input_labels_placeholders"),
+			LinksFocusedComponents:  String(d, "links_focused_components"),
+			PrimaryButton:           String(d, "primary_button"),
+			PrimaryButtonLabel:      String(d, "primary_button_label"),
+			SecondaryButtonBorder:   String(d, "secondary_button_border"),
+			SecondaryButtonLabel:    String(d, "secondary_button_label"),
+			Success:                 String(d, "success"),
+			WidgetBackground:        String(d, "widget_background"),
+			WidgetBorder:            String(d, "widget_border"),
+		}
+	})
+
+	List(d, "fonts").Elem(func(d ResourceData) {
+		t.Fonts = &brandingThemeFonts{
+			BodyText:          buildBrandingThemeText(d, "body_text"),
+			ButtonsText:       buildBrandingThemeText(d, "buttons_text"),
+			FontURL:           buildBrandingThemeURL(d, "font_url"),
+			InputLabels:       buildBrandingThemeText(d, "input_labels"),
+			Links:             buildBrandingThemeText(d, "links"),
+			LinksStyle:        String(d, "links_style"),
+			ReferenceTextSize: Float64(d, "reference_text_size"),
+			Subtitle:          buildBrandingThemeText(d, "subtitle"),
+			Title:             buildBrandingThemeText(d, "title"),
+		}
+	})
+
+	List(d, "page_background").Elem(func(d ResourceData) {
+		t.PageBackground = &brandingThemePageBackground{
+			BackgroundColor:    String(d, "background_color"),
+			BackgroundImageURL: buildBrandingThemeURL(d, "background_image_url"),
+			PageLayout:         String(d, "page_layout"),
+		}
+	})
+
+	List(d, "widget").Elem(func(d ResourceData) {
+		t.Widget = &brandingThemeWidget{
+			HeaderTextAlignment: String(d, "header_text_alignment"),
+			LogoHeight:          Float64(d, "logo_height"),
+			LogoPosition:        String(d, "logo_position"),
+			LogoURL:             buildBrandingThemeURL(d, "logo_url"),
+			SocialButtonsLayout: String(d, "social_buttons_layout"),
+		}
+	})
+
+	return t
+}
+
+// buildBrandingThemeURL returns the URL held by key. An empty string is
+// returned for a URL removed from the configuration, so that it is cleared.
+func buildBrandingThemeURL(d ResourceData, key string) *string {
+	if s := String(d, key); s != nil || !d.HasChange(key) {
+		return s
+	}
+	return auth0.String("")
+}
+
+func buildBrandingThemeText(d ResourceData, key string) (t *brandingThemeText) {
+	List(d, key).Elem(func(d ResourceData) {
+		t = &brandingThemeText{
+			Bold: Bool(d, "bold"),
+			Size: auth0.Float64(d.Get("size").(float64)),
+		}
+	})
+	return
+}
+
+func flattenBrandingThemeBorders(b *brandingThemeBorders) []interface{} {
+	if b == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"button_border_radius": b.ButtonBorderRadius,
+			"button_border_weight": b.ButtonBorderWeight,
+			"buttons_style":        b.ButtonsStyle,
+			"input_border_radius":  b.InputBorderRadius,
+			"input_border_weight":  b.InputBorderWeight,
+			"inputs_style":         b.InputsStyle,
+			"show_widget_shadow":   b.ShowWidgetShadow,
+			"widget_border_weight": b.WidgetBorderWeight,
+			"widget_corner_radius": b.WidgetCornerRadius,
+		},
+	}
+}
+
+func flattenBrandingThemeColors(c *brandingThemeColors) []interface{} {
+	if c == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"base_focus_color":          c.BaseFocusColor,
+			"base_hover_color":          c.BaseHoverColor,
+			"body_text":                 c.BodyText,
+			"captcha_widget_theme":      c.CaptchaWidgetTheme,
+			"error":                     c.Error,
+			"header":                    c.Header,
+			"icons":                     c.Icons,
+			"input_background":          c.InputBackground,
+			"input_border":              c.InputBorder,
+			"input_filled_text":         c.InputFilledText,
+			"input_labels_placeholders": c.InputLabelsPlaceholders,
+			"links_focused_components":  c.LinksFocusedComponents,
+			"primary_button":            c.PrimaryButton,
+			"primary_button_label":      c.PrimaryButtonLabel,
+			"secondary_button_border":   c.SecondaryButtonBorder,
+			"secondary_button_label":    c.SecondaryButtonLabel,
+			"success":                   c.Success,
+			"widget_background":         c.WidgetBackground,
+			"widget_border":             c.WidgetBorder,
+		},
+	}
+}
+
+func flattenBrandingThemeFonts(f *brandingThemeFonts) []interface{} {
+	if f == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"body_text":           flattenBrandingThemeText(f.BodyText),
+			"buttons_text":        flattenBrandingThemeText(f.ButtonsText),
+			"font_url":            f.FontURL,
+			"input_labels":        flattenBrandingThemeText(f.InputLabels),
+			"links":               flattenBrandingThemeText(f.Links),
+			"links_style":         f.LinksStyle,
+			"reference_text_size": f.ReferenceTextSize,
+			"subtitle":            flattenBrandingThemeText(f.Subtitle),
+			"title":               flattenBrandingThemeText(f.Title),
+		},
+	}
+}
+
+func flattenBrandingThemeText(t *brandingThemeText) []interface{} {
+	if t == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"bold": t.Bold,
+			"size": t.Size,
+		},
+	}
+}
+
+func flattenBrandingThemePageBackground(p *brandingThemePageBackground) []interface{} {
+	if p == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"background_color":     p.BackgroundColor,
+			"background_image_url": p.BackgroundImageURL,
+			"page_layout":          p.PageLayout,
+		},
+	}
+}
+
+func flattenBrandingThemeWidget(w *brandingThemeWidget) []interface{} {
+	if w == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"header_text_alignment": w.HeaderTextAlignment,
+			"logo_height":           w.LogoHeight,
+			"logo_position":         w.LogoPosition,
+			"logo_url":              w.LogoURL,
+			"social_buttons_layout": w.SocialButtonsLayout,
+		},
+	}
+}
@@ -0,0 +1,173 @@
+package auth0
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/auth0.v5"
+)
+
+const testAccBrandingThemeConfig = `
+resource "auth0_branding_theme" "my_theme" {
+	display_name = "My theme"
+	borders {
+		buttons_style = "pill"
+		widget_corner_radius = 10
+	}
+	colors {
+		primary_button = "#0059d6"
+		widget_background = "#fafafa"
+	}
+	fonts {
+		reference_text_size = 18
+		body_text {}
+		buttons_text {}
+		input_labels {}
+		links {}
+		subtitle {}
+		title {
+			bold = true
+		}
+	}
+	page_background {
+		background_color = "#000000"
+		page_layout = "left"
+	}
+	widget {
+		logo_position = "left"
+		logo_url = "https://mycompany.org/v1/logo.png"
+	}
+}
+`
+
+func TestAccBrandingTheme(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrandingThemeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "display_name", "My theme"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "borders.0.buttons_style", "pill"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "borders.0.widget_corner_radius", "10"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "borders.0.button_border_radius", "3"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "colors.0.primary_button", "#0059d6"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "colors.0.widget_background", "#fafafa"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "fonts.0.reference_text_size", "18"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "fonts.0.title.0.bold", "true"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "fonts.0.title.0.size", "150"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "page_background.0.page_layout", "left"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "widget.0.logo_position", "left"),
+					resource.TestCheckResourceAttr("auth0_branding_theme.my_theme", "widget.0.logo_url", "https://mycompany.org/v1/logo.png"),
+				),
+			},
+			{
+				Config: `
+resource "auth0_branding_theme" "my_theme" {
+	borders {}
+	colors {
+		primary_button = "blue"
+	}
+	fonts {
+		body_text {}
+		buttons_text {}
+		input_labels {}
+		links {}
+		subtitle {}
+		title {}
+	}
+	page_background {}
+	widget {}
+}
+`,
+				ExpectError: regexp.MustCompile(`expected "colors.0.primary_button" to be a hexadecimal color`),
+			},
+			{
+				Config: `
+resource "auth0_branding_theme" "my_theme" {
+	borders {
+		widget_corner_radius = 60
+	}
+	colors {}
+	fonts {
+		body_text {}
+		buttons_text {}
+		input_labels {}
+		links {}
+		subtitle {}
+		title {}
+	}
+	page_background {}
+	widget {}
+}
+`,
+				ExpectError: regexp.MustCompile(`expected borders.0.widget_corner_radius to be in the range \(0 - 50\)`),
+			},
+		},
+	})
+}
+
+func TestBuildBrandingThemeOmitsUnsetURLs(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, newBrandingTheme().Schema, map[string]interface{}{
+		"display_name": "My theme",
+		"fonts": []interface{}{
+			map[string]interface{}{"reference_text_size": 18},
+		},
+		"page_background": []interface{}{
+			map[string]interface{}{"background_color": "#000000"},
+		},
+		"widget": []interface{}{
+			map[string]interface{}{"logo_position": "left"},
+		},
+	})
+
+	theme := buildBrandingTheme(d)
+	if theme.Fonts.FontURL != nil || theme.PageBackground.BackgroundImageURL != nil || theme.Widget.LogoURL != nil {
+		t.Errorf("expected the unset URLs to be omitted, got %q, %q and %q",
+			auth0.StringValue(theme.Fonts.FontURL),
+			auth0.StringValue(theme.PageBackground.BackgroundImageURL),
+			auth0.StringValue(theme.Widget.LogoURL))
+	}
+}
+
+func TestBuildBrandingThemeClearsRemovedURLs(t *testing.T) {
+	d := testResourceDataUpdate(t, newBrandingTheme().Schema, map[string]string{
+		"display_name":                           "My theme",
+		"fonts.#":                                "1",
+		"fonts.0.font_url":                       "https://example.com/font.woff",
+		"fonts.0.reference_text_size":            "16",
+		"page_background.#":                      "1",
+		"page_background.0.background_color":     "#000000",
+		"page_background.0.background_image_url": "https://example.com/background.png",
+		"widget.#":                               "1",
+		"widget.0.logo_position":                 "left",
+		"widget.0.logo_url":                      "https://example.com/logo.png",
+	}, map[string]interface{}{
+		"display_name": "My theme",
+		"fonts": []interface{}{
+			map[string]interface{}{"reference_text_size": 16},
+		},
+		"page_background": []interface{}{
+			map[string]interface{}{
+				"background_color":     "#000000",
+				"background_image_url": "https://example.com/background.png",
+			},
+		},
+		"widget": []interface{}{
+			map[string]interface{}{"logo_position": "left"},
+		},
+	})
+
+	theme := buildBrandingTheme(d)
+	if theme.Fonts.FontURL == nil || *theme.Fonts.FontURL != "" {
+		t.Errorf("expected the removed font_url to be cleared, got %v", theme.Fonts.FontURL)
+	}
+	if theme.Widget.LogoURL == nil || *theme.Widget.LogoURL != "" {
+		t.Errorf("expected the removed logo_url to be cleared, got %v", theme.Widget.LogoURL)
+	}
+	if v := auth0.StringValue(theme.PageBackground.BackgroundImageURL); v != "https://example.com/background.png" {
+		t.Errorf("expected the background_image_url to be kept, got %q", v)
+	}
+}
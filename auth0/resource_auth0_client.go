@@ -2,9 +2,11 @@ package auth0
 
 import (
 	"context"
-	"strconv"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -15,13 +17,29 @@ import (
 	v "github.com/alekc/terraform-provider-auth0/auth0/internal/validation"
 )
 
+// client extends management.Client with the fields the SDK lacks.
+type client struct {
+	management.Client
+	CrossOriginAuthentication          *bool                        `json:"cross_origin_authentication,omitempty"`
+	OIDCBackchannelLogout              *clientOIDCBackchannelLogout `json:"oidc_backchannel_logout,omitempty"`
+	RequirePushedAuthorizationRequests *bool                        `json:"require_pushed_authorization_requests,omitempty"`
+}
+
+type clientOIDCBackchannelLogout struct {
+	BackchannelLogoutURLs []interface{} `json:"backchannel_logout_urls"`
+}
+
 func newClient() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 
 		CreateContext: createClient,
 		ReadContext:   readClient,
 		UpdateContext: updateClient,
 		DeleteContext: deleteClient,
+		CustomizeDiff: customdiff.All(
+			customizeClientSecretRotation,
+			validateClientRefreshToken,
+		),
 		Description: `With this resource, you can set up applications that use Auth0 for authentication and configure 
 allowed callback URLs and secrets for these applications. Depending on your plan, you may also configure add-ons to allow 
 your application to call another application's API (such as Firebase and AWS) on behalf of an authenticated user.`,
@@ -42,8 +60,9 @@ your application to call another application's API (such as Firebase and AWS) on
 				Description:  "Description of the purpose of the client (Max length = 140 characters)",
 			},
 			"client_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the client",
 			},
 			"client_secret": {
 				Type:        schema.TypeString,
@@ -55,7 +74,28 @@ your application to call another application's API (such as Firebase and AWS) on
 				Type:     schema.TypeMap,
 				Optional: true,
 				Description: "We recommend leaving the `client_secret` parameter unspecified to allow the generation" +
-					" of a safe secret",
+					" of a safe secret. Changing the content of this map rotates the secret",
+			},
+			"previous_client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+				Description: "The secret the client used before the last rotation, so that it can be phased out " +
+					"of the services using the client. Auth0 stops accepting it as soon as the secret is rotated",
+			},
+			"client_secret_rotated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The date the current secret was issued, in RFC3339 format. The issue date of the secret " +
+					"of imported clients, and of clients managed before this attribute existed, is unknown: it is " +
+					"the date the client was first read instead, from which `rotation_interval` is then measured",
+			},
+			"rotation_interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Maximum age of the client secret as a duration, e.g. `2160h`. Once exceeded, the plan " +
+					"rotates the secret. Auth0 stops accepting the old secret as soon as it is rotated",
+				ValidateFunc: validateClientSecretRotationInterval,
 			},
 			"app_type": {
 				Type:     schema.TypeString,
@@ -210,12 +250,26 @@ your application to call another application's API (such as Firebase and AWS) on
 				Optional: true,
 				Description: "Indicates whether or not the client can be used to make cross-origin authentication" +
 					" requests",
+				Deprecated:    "Use `cross_origin_authentication` instead",
+				ConflictsWith: []string{"cross_origin_authentication"},
+			},
+			"cross_origin_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				Description: "Indicates whether or not the client can be used to make cross-origin authentication" +
+					" requests",
+				ConflictsWith: []string{"cross_origin_auth"},
 			},
 			"cross_origin_loc": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "URL for the location on your site where the cross-origin verification takes place for" +
 					" the cross-origin auth flow. Used when performing auth in your own domain instead of through the Auth0-hosted login page",
+				ValidateFunc: validation.All(
+					validation.IsURLWithHTTPorHTTPS,
+					v.IsURLWithNoFragment,
+				),
 			},
 			"custom_login_page_on": {
 				Type:        schema.TypeBool,
@@ -233,286 +287,15 @@ your application to call another application's API (such as Firebase and AWS) on
 				Optional:    true,
 				Description: "Form template for WS-Federation protocol",
 			},
-			"addons": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				MaxItems:    1,
-				Description: "Configuration settings for add-ons for this client",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"aws": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"azure_blob": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"azure_sb": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"rms": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"mscrm": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"slack": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"sentry": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"box": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"cloudbees": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"concur": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"dropbox": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"echosign": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"egnyte": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"firebase": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"newrelic": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"office365": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"salesforce": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"salesforce_api": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"salesforce_sandbox_api": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"samlp": {
-							Type:        schema.TypeList,
-							MaxItems:    1,
-							Optional:    true,
-							Description: "Configuration settings for a SAML add-on",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"audience": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Description: "Audience of the SAML Assertion. " +
-											"Default will be the Issuer on SAMLRequest",
-									},
-									"recipient": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Description: "Recipient of the SAML Assertion (SubjectConfirmationData). " +
-											"Default is AssertionConsumerUrl on SAMLRequest or Callback URL if no SAMLRequest was sent",
-									},
-									"mappings": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     schema.TypeString,
-										Description: "Mappings between the Auth0 user profile property name (" +
-											"`name`) and the output attributes on the SAML attribute in the assertion (`value`)",
-									},
-									"create_upn_claim": {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     true,
-										Description: "Indicates whether or not a UPN claim should be created",
-									},
-									"passthrough_claims_with_no_mapping": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  true,
-										Description: "Indicates whether or not to passthrough claims that are not" +
-											" mapped to the common profile in the output assertion",
-									},
-									"map_unknown_claims_as_is": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  false,
-										Description: "Indicates whether or not to add a prefix of `http://schema." +
-											"auth0.com` to any claims that are not mapped to the common profile when passed through in the output assertion",
-									},
-									"map_identities": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  true,
-										Description: "Indicates whether or not to add additional identity information" +
-											" in the token, such as the provider used and the `access_token`, if available",
-									},
-									"signature_algorithm": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "rsa-sha1",
-										Description: "Algorithm used to sign the SAML Assertion or response. " +
-											"Options include `rsa-sha1` (default) and `rsa-sha256`",
-									},
-									"digest_algorithm": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "sha1",
-										Description: "Algorithm used to calculate the digest of the SAML Assertion or" +
-											" response. Options include `sha1` (default) and `sha256`",
-									},
-									"destination": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Description: "Destination of the SAML Response. If not specified, " +
-											"it will be `AssertionConsumerUrl` of SAMLRequest or `CallbackURL` if" +
-											" there was no SAMLRequest",
-									},
-									"lifetime_in_seconds": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Default:     3600,
-										Description: "Number of seconds during which the token is valid",
-									},
-									"sign_response": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Description: "Indicates whether or not the SAML Response should be signed instead" +
-											" of the SAML Assertion",
-									},
-									"name_identifier_format": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Default:     "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
-										Description: "Format of the name identifier",
-									},
-									"name_identifier_probes": {
-										Type:     schema.TypeList,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-										Optional: true,
-										Description: "Attributes that can be used for Subject/NameID. " +
-											"Auth0 will try each of the attributes of this array in order and use the" +
-											" first value it finds",
-									},
-									"authn_context_class_ref": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Class reference of the authentication context",
-									},
-									"typed_attributes": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  true,
-									},
-									"include_attribute_name_format": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  true,
-										Description: "Indicates whether or not we should infer the NameFormat based" +
-											" on the attribute name. If set to false, the attribute NameFormat is not set in the assertion",
-									},
-									"logout": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "Configuration settings for logout",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"callback": {
-													Type:     schema.TypeString,
-													Optional: true,
-													Description: "Service provider's Single Logout Service URL, " +
-														"to which Auth0 will send logout requests and responses",
-												},
-												"slo_enabled": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													Description: "Indicates whether or not Auth0 should notify" +
-														" service providers of session termination",
-												},
-											},
-										},
-									},
-									"binding": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Protocol binding used for SAML logout responses",
-									},
-									"signing_cert": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Description: "Optionally indicates the public key certificate used to validate " +
-											"SAML requests. If set, SAML requests will be required to be signed." +
-											" A sample value would be `-----BEGIN PUBLIC KEY-----...-----END PUBLIC KEY-----`",
-									},
-								},
-							},
-						},
-						"layer": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"sap_api": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"sharepoint": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"springcm": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"wams": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"wsfed": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"zendesk": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-						"zoom": {
-							Type:     schema.TypeMap,
-							Optional: true,
-						},
-					},
-				},
-				Default: nil,
-			},
+			"addons": clientAddonsSchema(),
 			"token_endpoint_auth_method": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				Description: "Defines the requested authentication method for the token endpoint. " +
 					"Options include `none` (public client without a client secret), " +
-					"`client_secret_post` (client uses HTTP POST parameters), `client_secret_basic` (client uses HTTP Basic)",
+					"`client_secret_post` (client uses HTTP POST parameters), `client_secret_basic` (client uses HTTP Basic). " +
+					"Leave it unset for clients using `private_key_jwt` with `auth0_client_credential`",
 				ValidateFunc: validation.StringInSlice([]string{
 					"none",
 					"client_secret_post",
@@ -591,6 +374,79 @@ your application to call another application's API (such as Firebase and AWS) on
 					},
 				},
 			},
+			"native_social_login": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Configuration settings to use the native social login of iOS and Android apps",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apple": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Description: "Native social login support for the Apple connection",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Indicates whether or not native social login is enabled for Apple",
+									},
+								},
+							},
+						},
+						"facebook": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Description: "Native social login support for the Facebook connection",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Indicates whether or not native social login is enabled for Facebook",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"oidc_backchannel_logout": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration settings for OIDC back-channel logout",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backchannel_logout_urls": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.All(
+									validation.IsURLWithScheme([]string{"https"}),
+									v.IsURLWithNoFragment,
+								),
+							},
+							Description: "URLs to which Auth0 sends the logout tokens when a session ends. They must be https",
+						},
+					},
+				},
+			},
+			"require_pushed_authorization_requests": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				Description: "Indicates whether or not the client must use Pushed Authorization Requests (PAR) " +
+					"to start the authorization flows",
+			},
 			"initiate_login_uri": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -659,28 +515,43 @@ your application to call another application's API (such as Firebase and AWS) on
 				},
 			},
 		},
+		SchemaVersion: 1,
+	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    clientSchemaV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: clientSchemaUpgradeV0,
+			Version: 0,
+		},
 	}
+	return r
 }
 
 func createClient(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := expandClient(d)
 	api := m.(*management.Management)
-	if err := api.Client.Create(c, management.Context(ctx)); err != nil {
+	if err := api.Request("POST", api.URI("clients"), c, management.Context(ctx)); err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(auth0.StringValue(c.ClientID))
+	_ = d.Set("client_secret_rotated_at", time.Now().UTC().Format(time.RFC3339))
 	return readClient(ctx, d, m)
 }
 
 func readClient(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*management.Management)
-	c, err := api.Client.Read(d.Id(), management.Context(ctx))
-	if err != nil {
+	var c *client
+	if err := api.Request("GET", api.URI("clients", d.Id()), &c, management.Context(ctx)); err != nil {
 		return flow.DefaultManagementError(err, d)
 	}
 
 	_ = d.Set("client_id", c.ClientID)
 	_ = d.Set("client_secret", c.ClientSecret)
+	// Secrets of unknown age, e.g. of imported clients, are considered issued
+	// when first read, so that they aren't rotated right away.
+	if rotatedAt, ok := d.Get("client_secret_rotated_at").(string); ok && rotatedAt == "" {
+		_ = d.Set("client_secret_rotated_at", time.Now().UTC().Format(time.RFC3339))
+	}
 	_ = d.Set("name", c.Name)
 	_ = d.Set("description", c.Description)
 	_ = d.Set("app_type", c.AppType)
@@ -699,6 +570,11 @@ func readClient(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	_ = d.Set("sso", c.SSO)
 	_ = d.Set("sso_disabled", c.SSODisabled)
 	_ = d.Set("cross_origin_auth", c.CrossOriginAuth)
+	if c.CrossOriginAuthentication != nil {
+		_ = d.Set("cross_origin_authentication", c.CrossOriginAuthentication)
+	} else {
+		_ = d.Set("cross_origin_authentication", c.CrossOriginAuth)
+	}
 	_ = d.Set("cross_origin_loc", c.CrossOriginLocation)
 	_ = d.Set("custom_login_page_on", c.CustomLoginPageOn)
 	_ = d.Set("custom_login_page", c.CustomLoginPage)
@@ -711,6 +587,9 @@ func readClient(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	_ = d.Set("client_metadata", c.ClientMetadata)
 	_ = d.Set("mobile", flattenMap(c.Mobile))
 	_ = d.Set("initiate_login_uri", c.InitiateLoginURI)
+	_ = d.Set("native_social_login", flattenClientNativeSocialLogin(c.NativeSocialLogin))
+	_ = d.Set("oidc_backchannel_logout", flattenClientOIDCBackchannelLogout(c.OIDCBackchannelLogout))
+	_ = d.Set("require_pushed_authorization_requests", c.RequirePushedAuthorizationRequests)
 
 	return nil
 }
@@ -757,6 +636,12 @@ func flattenAddons(addons map[string]interface{}) []interface{} {
 		}
 		result["samlp"] = []interface{}{samplpMap}
 	}
+
+	for name, addon := range clientAddons {
+		if data, ok := addons[name].(map[string]interface{}); ok {
+			result[name] = []interface{}{flattenClientAddon(data, addon)}
+		}
+	}
 	return []interface{}{result}
 }
 
@@ -764,7 +649,7 @@ func updateClient(ctx context.Context, d *schema.ResourceData, m interface{}) di
 	c := expandClient(d)
 	api := m.(*management.Management)
 	if clientHasChange(c) {
-		err := api.Client.Update(d.Id(), c, management.Context(ctx))
+		err := api.Request("PATCH", api.URI("clients", d.Id()), c, management.Context(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -787,9 +672,9 @@ func deleteClient(ctx context.Context, d *schema.ResourceData, m interface{}) di
 	return diag.FromErr(err)
 }
 
-func expandClient(d *schema.ResourceData) *management.Client {
+func expandClient(d *schema.ResourceData) *client {
 
-	c := &management.Client{
+	c := &client{Client: management.Client{
 		Name:                           String(d, "name"),
 		Description:                    String(d, "description"),
 		AppType:                        String(d, "app_type"),
@@ -814,6 +699,28 @@ func expandClient(d *schema.ResourceData) *management.Client {
 		FormTemplate:                   String(d, "form_template"),
 		TokenEndpointAuthMethod:        String(d, "token_endpoint_auth_method"),
 		InitiateLoginURI:               String(d, "initiate_login_uri"),
+	}}
+	c.CrossOriginAuthentication = Bool(d, "cross_origin_authentication")
+	c.RequirePushedAuthorizationRequests = Bool(d, "require_pushed_authorization_requests")
+
+	List(d, "native_social_login", IsNewResource(), HasChange()).Elem(func(d ResourceData) {
+		c.NativeSocialLogin = &management.ClientNativeSocialLogin{}
+		List(d, "apple").Elem(func(d ResourceData) {
+			c.NativeSocialLogin.Apple = map[string]interface{}{"enabled": d.Get("enabled")}
+		})
+		List(d, "facebook").Elem(func(d ResourceData) {
+			c.NativeSocialLogin.Facebook = map[string]interface{}{"enabled": d.Get("enabled")}
+		})
+	})
+
+	List(d, "oidc_backchannel_logout").Elem(func(d ResourceData) {
+		c.OIDCBackchannelLogout = &clientOIDCBackchannelLogout{
+			BackchannelLogoutURLs: Set(d, "backchannel_logout_urls").List(),
+		}
+	})
+	// Removing the block has to clear the URLs explicitly.
+	if c.OIDCBackchannelLogout == nil && !d.IsNewResource() && d.HasChange("oidc_backchannel_logout") {
+		c.OIDCBackchannelLogout = &clientOIDCBackchannelLogout{BackchannelLogoutURLs: []interface{}{}}
 	}
 
 	List(d, "refresh_token", IsNewResource(), HasChange()).Elem(func(d ResourceData) {
@@ -848,24 +755,11 @@ func expandClient(d *schema.ResourceData) *management.Client {
 
 		c.Addons = make(map[string]interface{})
 
-		// disabled due to https://community.auth0.com/t/new-customer-trying-to-authenticate-with-firebase-api-using-auth0/7534/18
-		// if there will be anyone complaining (from old customers),
-		// I will have a look at the underlying data to create proper structure. However, I think such event is unlikely
-
-		// for _, name := range []string{
-		// 	"aws", "azure_blob", "azure_sb", "rms", "mscrm", "slack", "sentry",
-		// 	"box", "cloudbees", "concur", "dropbox", "echosign", "egnyte",
-		// 	"firebase", "newrelic", "office365", "salesforce", "salesforce_api",
-		// 	"salesforce_sandbox_api", "layer", "sap_api", "sharepoint",
-		// 	"springcm", "wams", "wsfed", "zendesk", "zoom",
-		// } {
-		// 	_, ok := d.GetOk(name)
-		// 	if ok {
-		// 		c.Addons[name] = buildClientAddon(Map(d, name))
-		// 	}
-		// }
-		if _, ok := d.GetOk("wsfed"); ok {
-			c.Addons["wsfed"] = buildClientAddon(Map(d, "name"))
+		for name, addon := range clientAddons {
+			name, addon := name, addon
+			List(d, name).Elem(func(d ResourceData) {
+				c.Addons[name] = expandClientAddon(d, addon)
+			})
 		}
 
 		List(d, "samlp").Elem(func(d ResourceData) {
@@ -933,53 +827,124 @@ func expandClient(d *schema.ResourceData) *management.Client {
 	return c
 }
 
-func buildClientAddon(d map[string]interface{}) map[string]interface{} {
-
-	addon := make(map[string]interface{})
-
-	for key, value := range d {
-
-		switch v := value.(type) {
-
-		case string:
-			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
-				addon[key] = i
-			} else if f, err := strconv.ParseFloat(v, 64); err == nil {
-				addon[key] = f
-			} else if b, err := strconv.ParseBool(v); err == nil {
-				addon[key] = b
-			} else {
-				addon[key] = v
-			}
-
-		case map[string]interface{}:
-			addon[key] = buildClientAddon(v)
-
-		case []interface{}:
-			addon[key] = v
-
-		default:
-			addon[key] = v
-		}
-	}
-	return addon
-}
-
 func rotateClientSecret(ctx context.Context, d *schema.ResourceData, m interface{}) error {
-	if d.HasChange("client_secret_rotation_trigger") {
+	// client_secret_rotated_at is only part of the diff when
+	// customizeClientSecretRotation planned a rotation.
+	if d.HasChange("client_secret_rotation_trigger") || d.HasChange("client_secret_rotated_at") {
+		previous, _ := d.GetChange("client_secret")
 		api := m.(*management.Management)
 		c, err := api.Client.RotateSecret(d.Id(), management.Context(ctx))
 		if err != nil {
 			return err
 		}
 		_ = d.Set("client_secret", c.ClientSecret)
+		_ = d.Set("previous_client_secret", previous)
+		_ = d.Set("client_secret_rotated_at", time.Now().UTC().Format(time.RFC3339))
 	}
 	// d.SetPartial("client_secret_rotation_trigger")
 	return nil
 }
 
-func clientHasChange(c *management.Client) bool {
-	return c.String() != "{}"
+// customizeClientSecretRotation plans the rotation of the client secret when
+// the rotation trigger changes, or when the secret is older than the rotation
+// interval.
+func customizeClientSecretRotation(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	rotate := d.HasChange("client_secret_rotation_trigger")
+	if interval, ok := d.GetOk("rotation_interval"); ok && !rotate {
+		var err error
+		rotate, err = clientSecretRotationDue(d.Get("client_secret_rotated_at").(string), interval.(string), time.Now())
+		if err != nil {
+			return err
+		}
+	}
+	if !rotate {
+		return nil
+	}
+	for _, k := range []string{"client_secret", "previous_client_secret", "client_secret_rotated_at"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clientSecretRotationDue tells whether a secret issued at rotatedAt has
+// exceeded the rotation interval. Secrets of unknown age are considered issued
+// now, so they are never due.
+func clientSecretRotationDue(rotatedAt, interval string, now time.Time) (bool, error) {
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return false, err
+	}
+	if rotatedAt == "" {
+		return false, nil
+	}
+	t, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return false, fmt.Errorf("invalid client_secret_rotated_at %q: %w", rotatedAt, err)
+	}
+	return now.Sub(t) >= i, nil
+}
+
+func validateClientSecretRotationInterval(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("expected %q to be a duration, e.g. 2160h: %w", k, err))
+		return
+	}
+	if d <= 0 {
+		errs = append(errs, fmt.Errorf("expected %q to be positive, got %s", k, v))
+	}
+	return
+}
+
+func clientHasChange(c *client) bool {
+	return c.Client.String() != "{}" ||
+		c.CrossOriginAuthentication != nil ||
+		c.OIDCBackchannelLogout != nil ||
+		c.RequirePushedAuthorizationRequests != nil
+}
+
+// validateClientRefreshToken rejects the refresh token settings Auth0 only
+// reports as errors once the client is being updated.
+func validateClientRefreshToken(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rotationType := d.Get("refresh_token.0.rotation_type").(string)
+	if rotationType == "rotating" && d.Get("refresh_token.0.expiration_type").(string) == "non-expiring" {
+		return fmt.Errorf("refresh_token: rotating refresh tokens must have the `expiring` expiration_type")
+	}
+	if rotationType == "non-rotating" && d.Get("refresh_token.0.leeway").(int) > 0 {
+		return fmt.Errorf("refresh_token: leeway only applies to rotating refresh tokens")
+	}
+	return nil
+}
+
+func flattenClientNativeSocialLogin(l *management.ClientNativeSocialLogin) []interface{} {
+	if l == nil {
+		return nil
+	}
+	m := map[string]interface{}{}
+	if l.Apple != nil {
+		m["apple"] = []interface{}{map[string]interface{}{"enabled": l.Apple["enabled"]}}
+	}
+	if l.Facebook != nil {
+		m["facebook"] = []interface{}{map[string]interface{}{"enabled": l.Facebook["enabled"]}}
+	}
+	return []interface{}{m}
+}
+
+func flattenClientOIDCBackchannelLogout(l *clientOIDCBackchannelLogout) []interface{} {
+	if l == nil || len(l.BackchannelLogoutURLs) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{"backchannel_logout_urls": l.BackchannelLogoutURLs}}
 }
 
 func flattenClientJwtConfiguration(jwt *management.ClientJWTConfiguration) []interface{} {
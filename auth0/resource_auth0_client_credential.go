@@ -0,0 +1,333 @@
+package auth0
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+type clientCredential struct {
+	ID             *string    `json:"id,omitempty"`
+	Name           *string    `json:"name,omitempty"`
+	KeyID          *string    `json:"kid,omitempty"`
+	Algorithm      *string    `json:"alg,omitempty"`
+	CredentialType *string    `json:"credential_type,omitempty"`
+	PEM            *string    `json:"pem,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+}
+
+type clientAuthenticationMethods struct {
+	PrivateKeyJWT *clientPrivateKeyJWT `json:"private_key_jwt,omitempty"`
+}
+
+type clientPrivateKeyJWT struct {
+	Credentials []*clientCredential `json:"credentials"`
+}
+
+func newClientCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createClientCredential,
+		ReadContext:   readClientCredential,
+		UpdateContext: updateClientCredential,
+		DeleteContext: deleteClientCredential,
+		Importer: &schema.ResourceImporter{
+			StateContext: importClientCredential,
+		},
+		Description: `With this resource, you can register the public keys a client uses to authenticate with
+the ` + "`private_key_jwt`" + ` method. The credential is added to the ` + "`client_authentication_methods`" + ` of the client
+when created and removed from them when destroyed. Removing the last credential of a client restores the
+` + "`previous_token_endpoint_auth_method`" + `, which defaults to the ` + "`token_endpoint_auth_method`" + ` the client used
+when the credential was linked. If the client already used ` + "`private_key_jwt`" + ` then, e.g. because the credential
+replaces another one, and ` + "`previous_token_endpoint_auth_method`" + ` isn't set, the method of the client is left
+unset, and must be set with the ` + "`auth0_client`" + ` resource.
+
+Changing the key replaces the credential. To rotate keys without downtime, use the ` + "`create_before_destroy`" + `
+lifecycle setting, so that the new credential is linked to the client before the old one is removed.
+
+The resource can be imported using the ` + "`client_id::credential_id`" + ` format.`,
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the client the credential belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the credential",
+			},
+			"pem": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "PEM-formatted public key (`PUBLIC KEY`) or X.509 certificate (`CERTIFICATE`)",
+				ValidateFunc: validateClientCredentialPEM,
+				// Auth0 never returns the key, don't replace imported credentials.
+				DiffSuppressFunc: func(_, old, _ string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			"alg": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RS256",
+				Description:  "Algorithm used to sign the client assertions. Options include `RS256`, `RS384` and `PS256`",
+				ValidateFunc: validation.StringInSlice([]string{"RS256", "RS384", "PS256"}, false),
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The date the credential expires, in RFC3339 format",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressSameTime,
+			},
+			"previous_token_endpoint_auth_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Token endpoint authentication method restored on the client when its last credential " +
+					"is removed. Options include `none`, `client_secret_post` and `client_secret_basic`. Defaults to the " +
+					"method the client used when the credential was linked",
+				ValidateFunc: validation.StringInSlice([]string{"none", "client_secret_post", "client_secret_basic"}, false),
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The key identifier (`kid`) of the credential",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the credential was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the credential was last updated",
+			},
+		},
+	}
+}
+
+func createClientCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, err := buildClientCredential(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c.CredentialType = auth0.String("public_key")
+	c.PEM = String(d, "pem")
+	c.Algorithm = String(d, "alg")
+
+	api := m.(*management.Management)
+	clientID := d.Get("client_id").(string)
+	if err := api.Request("POST", api.URI("clients", clientID, "credentials"), c, management.Context(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(clientID + "::" + auth0.StringValue(c.ID))
+
+	previous, err := linkClientCredential(ctx, api, clientID, auth0.StringValue(c.ID), true, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, ok := d.GetOk("previous_token_endpoint_auth_method"); !ok {
+		_ = d.Set("previous_token_endpoint_auth_method", previous)
+	}
+	return readClientCredential(ctx, d, m)
+}
+
+func readClientCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientID, credentialID, err := parseClientCredentialID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	api := m.(*management.Management)
+	var c *clientCredential
+	err = api.Request("GET", api.URI("clients", clientID, "credentials", credentialID), &c, management.Context(ctx))
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+
+	_ = d.Set("client_id", clientID)
+	_ = d.Set("name", c.Name)
+	_ = d.Set("alg", c.Algorithm)
+	_ = d.Set("key_id", c.KeyID)
+	_ = d.Set("expires_at", formatTime(c.ExpiresAt))
+	_ = d.Set("created_at", formatTime(c.CreatedAt))
+	_ = d.Set("updated_at", formatTime(c.UpdatedAt))
+	return nil
+}
+
+func updateClientCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The previous token endpoint authentication method only exists in the
+	// state, only the expiration date is updated in Auth0.
+	if !d.HasChange("expires_at") {
+		return readClientCredential(ctx, d, m)
+	}
+	clientID, credentialID, err := parseClientCredentialID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c, err := buildClientCredential(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	c.Name = nil
+
+	api := m.(*management.Management)
+	err = api.Request("PATCH", api.URI("clients", clientID, "credentials", credentialID), c, management.Context(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return readClientCredential(ctx, d, m)
+}
+
+func deleteClientCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientID, credentialID, err := parseClientCredentialID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Auth0 refuses to delete a credential which is still in use, so it has
+	// to be removed from the authentication methods of the client first.
+	api := m.(*management.Management)
+	restore := d.Get("previous_token_endpoint_auth_method").(string)
+	if _, err := linkClientCredential(ctx, api, clientID, credentialID, false, restore); err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	err = api.Request("DELETE", api.URI("clients", clientID, "credentials", credentialID), nil, management.Context(ctx))
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	return nil
+}
+
+func importClientCredential(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	clientID, _, err := parseClientCredentialID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("client_id", clientID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildClientCredential(d *schema.ResourceData) (*clientCredential, error) {
+	c := &clientCredential{
+		Name: String(d, "name"),
+	}
+	if v, ok := d.GetOk("expires_at"); ok && d.HasChange("expires_at") {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		c.ExpiresAt = &t
+	}
+	return c, nil
+}
+
+// linkClientCredential adds the credential to, or removes it from, the
+// private_key_jwt authentication method of the client, returning the token
+// endpoint authentication method the client used before. Once the last
+// credential is removed, the client is switched back to the restore method,
+// unless it is empty.
+func linkClientCredential(ctx context.Context, api *management.Management, clientID, credentialID string, link bool, restore string) (string, error) {
+	globalMutex.Lock("client:" + clientID)
+	defer globalMutex.Unlock("client:" + clientID)
+
+	var c struct {
+		TokenEndpointAuthMethod     *string                      `json:"token_endpoint_auth_method,omitempty"`
+		ClientAuthenticationMethods *clientAuthenticationMethods `json:"client_authentication_methods,omitempty"`
+	}
+	err := api.Request("GET", api.URI("clients", clientID), &c, management.Context(ctx),
+		management.WithFields("token_endpoint_auth_method", "client_authentication_methods"))
+	if err != nil {
+		return "", err
+	}
+
+	var credentials []*clientCredential
+	if c.ClientAuthenticationMethods != nil && c.ClientAuthenticationMethods.PrivateKeyJWT != nil {
+		for _, credential := range c.ClientAuthenticationMethods.PrivateKeyJWT.Credentials {
+			if auth0.StringValue(credential.ID) != credentialID {
+				credentials = append(credentials, &clientCredential{ID: credential.ID})
+			}
+		}
+	}
+	if link {
+		credentials = append(credentials, &clientCredential{ID: auth0.String(credentialID)})
+	}
+
+	body := map[string]interface{}{
+		"client_authentication_methods": nil,
+	}
+	if len(credentials) > 0 {
+		body["client_authentication_methods"] = &clientAuthenticationMethods{
+			PrivateKeyJWT: &clientPrivateKeyJWT{Credentials: credentials},
+		}
+		body["token_endpoint_auth_method"] = nil
+	} else if restore != "" {
+		body["token_endpoint_auth_method"] = restore
+	}
+	err = api.Request("PATCH", api.URI("clients", clientID), &body, management.Context(ctx))
+	return auth0.StringValue(c.TokenEndpointAuthMethod), err
+}
+
+func validateClientCredentialPEM(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+	block, _ := pem.Decode([]byte(strings.TrimSpace(v)))
+	if block == nil {
+		errs = append(errs, fmt.Errorf("expected %q to be a PEM encoded public key or certificate", k))
+		return
+	}
+	if block.Type != "PUBLIC KEY" && block.Type != "CERTIFICATE" {
+		errs = append(errs, fmt.Errorf("expected %q to be a PUBLIC KEY or a CERTIFICATE, got %s", k, block.Type))
+	}
+	return
+}
+
+func suppressSameTime(_, old, new string, _ *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	n, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return o.Equal(n)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func parseClientCredentialID(id string) (clientID string, credentialID string, err error) {
+	parts := strings.Split(id, "::")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected client_id::credential_id", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -2,6 +2,10 @@ package auth0
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,7 +16,7 @@ import (
 )
 
 func newClientGrant() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: createClientGrant,
 		ReadContext:   readClientGrant,
 		UpdateContext: updateClientGrant,
@@ -25,7 +29,13 @@ Auth0 uses various grant types,
 or methods by which you grant limited access to your resources to another entity without exposing credentials. 
 The OAuth 2.0 protocol supports several types of grants, which allow different types of access. 
 This resource allows you to create and manage client grants used with configured Auth0 clients.   
+
+Only one grant can exist for a client and audience. Set ` + "`adopt_existing`" + ` to take over a grant which was
+created outside of Terraform instead of failing.
+
+The scopes are validated at plan time against the scopes defined by the resource server of the ` + "`audience`" + `.
 `,
+		CustomizeDiff: validateClientGrantScopes,
 
 		Schema: map[string]*schema.Schema{
 			"client_id": {
@@ -39,17 +49,51 @@ This resource allows you to create and manage client grants used with configured
 				ForceNew: true,
 			},
 			"scope": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Required: true,
 			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Whether an existing grant for the same client and audience is taken over on creation, " +
+					"updating its scopes, instead of failing",
+			},
+			"validate_scopes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Whether the scopes are validated at plan time against the scopes the resource server " +
+					"currently defines. Leave it unset when the resource server or its scopes are created in the " +
+					"same apply, or can't be read with the credentials of the provider",
+			},
 		},
+		SchemaVersion: 1,
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    clientGrantSchemaV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: clientGrantSchemaUpgradeV0,
+			Version: 0,
+		},
+	}
+	return r
 }
 
 func createClientGrant(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientGrant := buildClientGrant(d)
 	api := m.(*management.Management)
+	if d.Get("adopt_existing").(bool) {
+		g, err := findClientGrant(ctx, api, clientGrant.GetClientID(), clientGrant.GetAudience())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if g != nil {
+			d.SetId(g.GetID())
+			return updateClientGrant(ctx, d, m)
+		}
+	}
 	if err := api.ClientGrant.Create(clientGrant, management.Context(ctx)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -96,10 +140,123 @@ func buildClientGrant(d *schema.ResourceData) *management.ClientGrant {
 		ClientID: String(d, "client_id"),
 		Audience: String(d, "audience"),
 	}
-	if scope, ok := d.GetOk("scope"); ok {
-		clientGrant.Scope = scope.([]interface{})
-	} else {
+	clientGrant.Scope = Set(d, "scope").List()
+	if clientGrant.Scope == nil {
 		clientGrant.Scope = []interface{}{}
 	}
 	return clientGrant
 }
+
+// findClientGrant looks up the grant of the client for the audience, returning
+// nil if there is none.
+func findClientGrant(ctx context.Context, api *management.Management, clientID, audience string) (*management.ClientGrant, error) {
+	var page int
+	for {
+		l, err := api.ClientGrant.List(
+			management.Parameter("client_id", clientID),
+			management.Parameter("audience", audience),
+			management.Page(page),
+			management.Context(ctx),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range l.ClientGrants {
+			if g.GetClientID() == clientID && g.GetAudience() == audience {
+				return g, nil
+			}
+		}
+		if !l.HasNext() {
+			return nil, nil
+		}
+		page++
+	}
+}
+
+// validateClientGrantScopes rejects the scopes which aren't defined by the
+// resource server of the audience.
+func validateClientGrantScopes(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if m == nil || !d.Get("validate_scopes").(bool) ||
+		!d.NewValueKnown("audience") || !d.NewValueKnown("scope") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("audience") && !d.HasChange("scope") {
+		return nil
+	}
+	audience := d.Get("audience").(string)
+
+	api := m.(*management.Management)
+	var s *management.ResourceServer
+	err := api.Request("GET", resourceServerURI(api, audience), &s, management.Context(ctx))
+	if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusNotFound {
+		return fmt.Errorf("the resource server %q doesn't exist, unset validate_scopes "+
+			"if it is created in the same apply", audience)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the resource server %q to validate the scopes, "+
+			"unset validate_scopes to skip the validation: %w", audience, err)
+	}
+
+	if undefined := undefinedClientGrantScopes(d.Get("scope").(*schema.Set).List(), s.Scopes); len(undefined) > 0 {
+		return fmt.Errorf("the scopes %s aren't defined by the resource server %q",
+			strings.Join(undefined, ", "), audience)
+	}
+	return nil
+}
+
+// undefinedClientGrantScopes returns the sorted scopes which aren't in defined.
+func undefinedClientGrantScopes(scopes []interface{}, defined []*management.ResourceServerScope) []string {
+	values := make(map[string]bool, len(defined))
+	for _, scope := range defined {
+		values[scope.GetValue()] = true
+	}
+	var undefined []string
+	for _, scope := range scopes {
+		if v, ok := scope.(string); ok && !values[v] {
+			undefined = append(undefined, v)
+		}
+	}
+	sort.Strings(undefined)
+	return undefined
+}
+
+// clientGrantSchemaV0 is the schema of the client grant before the scopes were
+// a set. It is frozen, only the types matter to decode the state.
+func clientGrantSchemaV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"audience": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+			},
+		},
+	}
+}
+
+// clientGrantSchemaUpgradeV0 turns the list of scopes into a set, dropping the
+// duplicates.
+func clientGrantSchemaUpgradeV0(ctx context.Context, state map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	l, ok := state["scope"].([]interface{})
+	if !ok {
+		return state, nil
+	}
+	seen := make(map[interface{}]bool, len(l))
+	scopes := make([]interface{}, 0, len(l))
+	for _, scope := range l {
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	state["scope"] = scopes
+	return state, nil
+}
@@ -1,10 +1,15 @@
 package auth0
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
 )
 
 func TestAccClientGrant(t *testing.T) {
@@ -26,7 +31,7 @@ func TestAccClientGrant(t *testing.T) {
 				Config: random.Template(testAccClientGrantConfigUpdate, rand),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("auth0_client_grant.my_client_grant", "scope.#", "1"),
-					resource.TestCheckResourceAttr("auth0_client_grant.my_client_grant", "scope.0", "create:foo"),
+					resource.TestCheckTypeSetElemAttr("auth0_client_grant.my_client_grant", "scope.*", "create:foo"),
 				),
 			},
 			{
@@ -108,3 +113,140 @@ resource "auth0_client_grant" "my_client_grant" {
 	scope = [ ]
 }
 `
+
+func TestAccClientGrantAdoptExisting(t *testing.T) {
+
+	rand := random.String(6)
+
+	// The grant is created outside of Terraform, once the client and the
+	// resource server exist.
+	var clientID string
+	createGrant := func() {
+		err := testAuth0ApiClient().ClientGrant.Create(&management.ClientGrant{
+			ClientID: auth0.String(clientID),
+			Audience: auth0.String(random.Template("https://uat.tf.alexkappa.com/client-grant/{{.random}}", rand)),
+			Scope:    []interface{}{"create:bar"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccClientGrantAuxConfig, rand),
+				Check: func(s *terraform.State) error {
+					clientID = s.RootModule().Resources["auth0_client.my_client"].Primary.ID
+					return nil
+				},
+			},
+			{
+				PreConfig: createGrant,
+				Config:    random.Template(testAccClientGrantConfigAdoptExisting, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_client_grant.my_client_grant", "client_id", "auth0_client.my_client", "id"),
+					resource.TestCheckResourceAttr("auth0_client_grant.my_client_grant", "scope.#", "1"),
+					resource.TestCheckTypeSetElemAttr("auth0_client_grant.my_client_grant", "scope.*", "create:foo"),
+				),
+			},
+		},
+	})
+}
+
+const testAccClientGrantConfigAdoptExisting = testAccClientGrantAuxConfig + `
+
+resource "auth0_client_grant" "my_client_grant" {
+	client_id = auth0_client.my_client.id
+	audience = auth0_resource_server.my_resource_server.identifier
+	scope = [ "create:foo" ]
+	adopt_existing = true
+}
+`
+
+func TestAccClientGrantUndefinedScope(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccClientGrantAuxConfig, rand),
+			},
+			{
+				Config:      random.Template(testAccClientGrantConfigUndefinedScope, rand),
+				ExpectError: regexp.MustCompile(`the scopes create:baz aren't defined by the resource server`),
+			},
+			{
+				Config: random.Template(testAccClientGrantAuxConfig+`
+
+resource "auth0_client_grant" "my_client_grant" {
+	client_id = auth0_client.my_client.id
+	audience = "https://uri.{{.random}}.acceptance.test.com/missing"
+	scope = [ "create:foo" ]
+	validate_scopes = true
+}
+`, rand),
+				ExpectError: regexp.MustCompile(`the resource server "https://uri\.[a-zA-Z0-9]+\.acceptance\.test\.com/missing" doesn't exist`),
+			},
+			{
+				Config: random.Template(testAccClientGrantAuxConfig+`
+
+resource "auth0_client_grant" "my_client_grant" {
+	client_id = auth0_client.my_client.id
+	audience = auth0_resource_server.my_resource_server.identifier
+	scope = [ "create:foo", "create:baz" ]
+}
+`, rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+const testAccClientGrantConfigUndefinedScope = testAccClientGrantAuxConfig + `
+
+resource "auth0_client_grant" "my_client_grant" {
+	client_id = auth0_client.my_client.id
+	audience = auth0_resource_server.my_resource_server.identifier
+	scope = [ "create:foo", "create:baz" ]
+	validate_scopes = true
+}
+`
+
+func TestUndefinedClientGrantScopes(t *testing.T) {
+	undefined := undefinedClientGrantScopes(
+		[]interface{}{"read:foo", "create:foo", "delete:foo"},
+		[]*management.ResourceServerScope{
+			{Value: auth0.String("create:foo")},
+			{Value: auth0.String("update:foo")},
+		},
+	)
+	if expected := []string{"delete:foo", "read:foo"}; !reflect.DeepEqual(expected, undefined) {
+		t.Errorf("expected %v, got %v", expected, undefined)
+	}
+}
+
+func TestClientGrantInstanceStateUpgradeV0(t *testing.T) {
+	state := map[string]interface{}{
+		"client_id": "client",
+		"scope":     []interface{}{"create:foo", "create:bar", "create:foo"},
+	}
+
+	actual, err := clientGrantSchemaUpgradeV0(nil, state, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if expected := []interface{}{"create:foo", "create:bar"}; !reflect.DeepEqual(expected, actual["scope"]) {
+		t.Errorf("expected %v, got %v", expected, actual["scope"])
+	}
+	if actual["client_id"] != "client" {
+		t.Errorf("expected the other attributes to be left untouched, got %#v", actual)
+	}
+}
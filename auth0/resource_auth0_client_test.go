@@ -1,14 +1,21 @@
 package auth0
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/management"
 )
 
@@ -231,6 +238,7 @@ resource "auth0_client" "my_client" {
 func TestAccClientRotateSecret(t *testing.T) {
 
 	rand := random.String(6)
+	var secret string
 
 	resource.ParallelTest(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
@@ -244,6 +252,12 @@ resource "auth0_client" "my_client" {
 `, rand),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					random.TestCheckResourceAttr("auth0_client.my_client", "name", "Acceptance Test - Rotate Secret - {{.random}}", rand),
+					resource.TestCheckResourceAttrSet("auth0_client.my_client", "client_secret_rotated_at"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "previous_client_secret", ""),
+					func(s *terraform.State) error {
+						secret = s.RootModule().Resources["auth0_client.my_client"].Primary.Attributes["client_secret"]
+						return nil
+					},
 				),
 			},
 			{
@@ -260,6 +274,12 @@ resource "auth0_client" "my_client" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("auth0_client.my_client", "client_secret_rotation_trigger.triggered_at", "2018-01-02T23:12:01Z"),
 					resource.TestCheckResourceAttr("auth0_client.my_client", "client_secret_rotation_trigger.triggered_by", "alex"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["auth0_client.my_client"].Primary.Attributes["client_secret"] == secret {
+							return fmt.Errorf("expected the client secret to be rotated")
+						}
+						return resource.TestCheckResourceAttr("auth0_client.my_client", "previous_client_secret", secret)(s)
+					},
 				),
 			},
 		},
@@ -425,3 +445,330 @@ resource "auth0_client" "my_client" {
 		},
 	})
 }
+
+func TestClientSecretRotationDue(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		rotatedAt string
+		interval  string
+		due       bool
+	}{
+		{rotatedAt: "2021-05-31T12:00:00Z", interval: "48h", due: false},
+		{rotatedAt: "2021-05-30T12:00:00Z", interval: "48h", due: true},
+		{rotatedAt: "2021-01-01T00:00:00Z", interval: "2160h", due: true},
+		{rotatedAt: "", interval: "2160h", due: false},
+	} {
+		due, err := clientSecretRotationDue(tc.rotatedAt, tc.interval, now)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if due != tc.due {
+			t.Errorf("expected rotation of a secret issued at %q with an interval of %s to be due: %t", tc.rotatedAt, tc.interval, tc.due)
+		}
+	}
+
+	if _, err := clientSecretRotationDue("yesterday", "48h", now); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
+
+func TestValidateClientSecretRotationInterval(t *testing.T) {
+	for interval, valid := range map[string]bool{"2160h": true, "30m": true, "90d": false, "-1h": false, "0s": false} {
+		_, errs := validateClientSecretRotationInterval(interval, "rotation_interval")
+		if valid != (len(errs) == 0) {
+			t.Errorf("expected %q to be valid: %t, got %v", interval, valid, errs)
+		}
+	}
+}
+
+func TestAccClientNativeSocialLogin(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+resource "auth0_client" "my_client" {
+  name = "Acceptance Test - Native Social Login - {{.random}}"
+  app_type = "native"
+  cross_origin_authentication = true
+  cross_origin_loc = "https://example.com/cross-origin"
+  native_social_login {
+    apple {
+      enabled = true
+    }
+    facebook {
+      enabled = false
+    }
+  }
+  oidc_backchannel_logout {
+    backchannel_logout_urls = ["https://example.com/logout"]
+  }
+}
+
+data "auth0_client" "my_client" {
+  client_id = auth0_client.my_client.client_id
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_client.my_client", "cross_origin_authentication", "true"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "native_social_login.0.apple.0.enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "native_social_login.0.facebook.0.enabled", "false"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "oidc_backchannel_logout.0.backchannel_logout_urls.#", "1"),
+					resource.TestCheckResourceAttr("data.auth0_client.my_client", "native_social_login.0.apple.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.auth0_client.my_client", "oidc_backchannel_logout.0.backchannel_logout_urls.#", "1"),
+					resource.TestCheckResourceAttr("data.auth0_client.my_client", "cross_origin_authentication", "true"),
+				),
+			},
+			{
+				Config: random.Template(`
+resource "auth0_client" "my_client" {
+  name = "Acceptance Test - Native Social Login - {{.random}}"
+  app_type = "native"
+  cross_origin_authentication = true
+  cross_origin_loc = "https://example.com/cross-origin"
+  native_social_login {
+    apple {
+      enabled = true
+    }
+    facebook {
+      enabled = false
+    }
+  }
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_client.my_client", "oidc_backchannel_logout.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClientRefreshTokenValidationError(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+resource "auth0_client" "my_client" {
+  name = "Acceptance Test - Refresh Token - {{.random}}"
+  refresh_token {
+    rotation_type = "rotating"
+    expiration_type = "non-expiring"
+  }
+}
+`, rand),
+				ExpectError: regexp.MustCompile("rotating refresh tokens must have the `expiring` expiration_type"),
+			},
+		},
+	})
+}
+
+func TestAccClientAddons(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+resource "auth0_client" "my_client" {
+  name = "Acceptance Test - Addons - {{.random}}"
+  addons {
+    aws {
+      principal           = "arn:aws:iam::010616021751:saml-provider/idpname"
+      role                = "arn:aws:iam::010616021751:role/foo"
+      lifetime_in_seconds = 1800
+    }
+    azure_blob {
+      account_name   = "acme-org"
+      container_name = "my-container"
+      expiration     = 10
+      blob_read      = true
+      container_list = true
+    }
+    sharepoint {
+      url          = "https://acme-org.sharepoint.com"
+      external_url = ["https://acme-org.com/sharepoint"]
+    }
+    box {}
+  }
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.aws.0.principal", "arn:aws:iam::010616021751:saml-provider/idpname"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.aws.0.lifetime_in_seconds", "1800"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.azure_blob.0.expiration", "10"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.azure_blob.0.blob_read", "true"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.azure_blob.0.container_list", "true"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.sharepoint.0.external_url.0", "https://acme-org.com/sharepoint"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.box.#", "1"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.firebase.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestClientAddonsExpandFlatten(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, newClient().Schema, map[string]interface{}{
+		"name": "test",
+		"addons": []interface{}{
+			map[string]interface{}{
+				"aws": []interface{}{
+					map[string]interface{}{"principal": "arn:principal", "lifetime_in_seconds": 1800},
+				},
+				"sap_api": []interface{}{
+					map[string]interface{}{"client_id": "sap", "token_endpoint_url": "https://sap.example.com/token"},
+				},
+				"sharepoint": []interface{}{
+					map[string]interface{}{"external_url": []interface{}{"https://example.com"}},
+				},
+			},
+		},
+	})
+
+	addons := expandClient(d).Addons
+	expected := map[string]interface{}{
+		"aws":        MapData{"principal": auth0.String("arn:principal"), "lifetime_in_seconds": auth0.Int(1800)},
+		"sap_api":    MapData{"clientid": auth0.String("sap"), "tokenEndpointUrl": auth0.String("https://sap.example.com/token")},
+		"sharepoint": MapData{"external_url": []interface{}{"https://example.com"}},
+	}
+	if !reflect.DeepEqual(addons, expected) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, addons)
+	}
+
+	// Auth0 returns numbers as float64.
+	flattened := flattenAddons(map[string]interface{}{
+		"aws":     map[string]interface{}{"principal": "arn:principal", "lifetime_in_seconds": float64(1800)},
+		"sap_api": map[string]interface{}{"clientid": "sap", "unknown": "ignored"},
+		"box":     map[string]interface{}{},
+	})
+	expectedFlattened := []interface{}{
+		map[string]interface{}{
+			"aws":     []interface{}{map[string]interface{}{"principal": "arn:principal", "lifetime_in_seconds": 1800}},
+			"sap_api": []interface{}{map[string]interface{}{"client_id": "sap"}},
+			"box":     []interface{}{map[string]interface{}{}},
+		},
+	}
+	if !reflect.DeepEqual(flattened, expectedFlattened) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expectedFlattened, flattened)
+	}
+}
+
+func TestClientInstanceStateUpgradeV0(t *testing.T) {
+	samlp := []interface{}{map[string]interface{}{"audience": "https://example.com/saml"}}
+	state := map[string]interface{}{
+		"name": "test",
+		"addons": []interface{}{
+			map[string]interface{}{
+				"aws": map[string]interface{}{
+					"principal":           "arn:principal",
+					"lifetime_in_seconds": "1800",
+				},
+				"azure_blob": map[string]interface{}{
+					"accountName": "acme-org",
+					"blob_read":   "true",
+					"unknown":     "dropped",
+				},
+				"firebase": map[string]interface{}{},
+				"samlp":    samlp,
+			},
+		},
+	}
+
+	actual, err := clientSchemaUpgradeV0(nil, state, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	addons := actual["addons"].([]interface{})[0].(map[string]interface{})
+	for name, expected := range map[string]interface{}{
+		"aws": []interface{}{
+			map[string]interface{}{"principal": "arn:principal", "lifetime_in_seconds": 1800},
+		},
+		"azure_blob": []interface{}{
+			map[string]interface{}{"account_name": "acme-org", "blob_read": true},
+		},
+		"firebase": []interface{}{},
+		"zoom":     []interface{}{},
+		"samlp":    samlp,
+	} {
+		if !reflect.DeepEqual(expected, addons[name]) {
+			t.Errorf("addons.%s:\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", name, expected, addons[name])
+		}
+	}
+	if actual["name"] != "test" {
+		t.Errorf("expected the other attributes to be left untouched, got %#v", actual)
+	}
+}
+
+func TestClientSchemaV0(t *testing.T) {
+	s := clientSchemaV0()
+	if err := schema.InternalMap(s.Schema).InternalValidate(nil); err != nil {
+		t.Fatal(err)
+	}
+	addons := s.Schema["addons"].Elem.(*schema.Resource).Schema
+	if addons["aws"].Type != schema.TypeMap {
+		t.Errorf("expected the V0 addons to be maps, got %s", addons["aws"].Type)
+	}
+	for _, k := range []string{"rotation_interval", "native_social_login"} {
+		if _, ok := s.Schema[k]; ok {
+			t.Errorf("expected %s, added after V0, to be left out of the V0 schema", k)
+		}
+	}
+}
+
+func TestExpandClientExtensions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, newClient().Schema, map[string]interface{}{
+		"name":                                  "test",
+		"cross_origin_authentication":           true,
+		"require_pushed_authorization_requests": true,
+		"native_social_login": []interface{}{
+			map[string]interface{}{
+				"apple": []interface{}{map[string]interface{}{"enabled": true}},
+			},
+		},
+		"oidc_backchannel_logout": []interface{}{
+			map[string]interface{}{"backchannel_logout_urls": []interface{}{"https://example.com/logout"}},
+		},
+	})
+
+	b, err := json.Marshal(expandClient(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"name":"test","native_social_login":{"apple":{"enabled":true}},` +
+		`"cross_origin_authentication":true,` +
+		`"oidc_backchannel_logout":{"backchannel_logout_urls":["https://example.com/logout"]},` +
+		`"require_pushed_authorization_requests":true}`
+	if string(b) != expected {
+		t.Errorf("\n\nexpected:\n\n%s\n\ngot:\n\n%s\n\n", expected, b)
+	}
+}
+
+func TestExpandClientRemovesBackchannelLogout(t *testing.T) {
+	d := testResourceDataUpdate(t, newClient().Schema, map[string]string{
+		"name":                      "test",
+		"oidc_backchannel_logout.#": "1",
+		"oidc_backchannel_logout.0.backchannel_logout_urls.#": "1",
+		"oidc_backchannel_logout.0.backchannel_logout_urls.1": "https://example.com/logout",
+	}, map[string]interface{}{
+		"name": "test",
+	})
+
+	b, err := json.Marshal(expandClient(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"name":"test","oidc_backchannel_logout":{"backchannel_logout_urls":[]}}`; string(b) != expected {
+		t.Errorf("\n\nexpected:\n\n%s\n\ngot:\n\n%s\n\n", expected, b)
+	}
+}
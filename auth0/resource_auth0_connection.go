@@ -2,12 +2,21 @@ package auth0
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
 	"log"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"gopkg.in/auth0.v5"
@@ -29,7 +38,12 @@ which may include identity providers (such as Google or LinkedIn), databases,
 or passwordless authentication methods. This resource allows you to configure and manage connections to be used with
 your clients and users.
 `,
-		Schema:        connectionSchema,
+		Schema: connectionSchema,
+		CustomizeDiff: customdiff.All(
+			validateConnectionOptions,
+			validateConnectionCustomScripts,
+			customizeConnectionScriptHashes,
+		),
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -81,7 +95,7 @@ var connectionSchema = map[string]*schema.Schema{
 			"soundcloud", "thecity-sandbox", "thecity",
 			"thirtysevensignals", "twitter", "untappd", "vkontakte",
 			"waad", "weibo", "windowslive", "wordpress", "yahoo",
-			"yammer", "yandex", "line",
+			"yammer", "yandex", "line", "okta",
 		}, true),
 		ForceNew: true,
 		Description: "Type of the connection, which indicates the identity provider. Options include `ad`, `adfs`, " +
@@ -92,12 +106,13 @@ var connectionSchema = map[string]*schema.Schema{
 			"`pingfederate`, `planningcenter`,`renren`, `salesforce-community`, `salesforce-sandbox`,`salesforce`, " +
 			"`samlp`, `sharepoint`, `shopify`, `sms`,`soundcloud`, `thecity-sandbox`, `thecity`,`thirtysevensignals`," +
 			" `twitter`, `untappd`, `vkontakte`,`waad`, `weibo`, `windowslive`, `wordpress`, `yahoo`,`yammer`, " +
-			"`yandex`, `line`",
+			"`yandex`, `line`, `okta`",
 	},
 	"options": {
-		Type:     schema.TypeList,
-		Required: true,
-		MaxItems: 1,
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"options", "options_json"},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"validation": {
@@ -232,13 +247,22 @@ var connectionSchema = map[string]*schema.Schema{
 					Description: "Indicates whether or not the user is required to provide a username in addition to an email address",
 				},
 				"custom_scripts": {
-					Type:     schema.TypeMap,
-					Elem:     &schema.Schema{Type: schema.TypeString},
-					Optional: true,
+					Type:         schema.TypeMap,
+					Elem:         &schema.Schema{Type: schema.TypeString},
+					Optional:     true,
+					ValidateFunc: validateConnectionScriptNames,
 					Description: "Custom database action scripts. For more information, " +
 						"read [Custom Database Action Script Templates](https://auth0." +
 						"com/docs/connections/database/custom-db/templates)",
 				},
+				"custom_script_files": {
+					Type:         schema.TypeMap,
+					Elem:         &schema.Schema{Type: schema.TypeString},
+					Optional:     true,
+					ValidateFunc: validateConnectionScriptFiles,
+					Description: "Paths to files holding custom database action scripts, keyed by script name. " +
+						"The content of the files is tracked by `custom_script_hashes`",
+				},
 				"scripts": {
 					Type:        schema.TypeMap,
 					Elem:        &schema.Schema{Type: schema.TypeString},
@@ -475,6 +499,14 @@ var connectionSchema = map[string]*schema.Schema{
 					Optional: true,
 				},
 
+				// pingfederate options
+				"ping_federate_base_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "PingFederate server base URL",
+				},
+
 				// salesforce options
 				"community_base_url": {
 					Type:     schema.TypeString,
@@ -626,6 +658,21 @@ var connectionSchema = map[string]*schema.Schema{
 		},
 		Description: "Configuration settings for connection options",
 	},
+	"custom_script_hashes": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "SHA256 hashes of the custom database action scripts loaded from `options.custom_script_files`",
+	},
+	"options_json": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+		Description: "JSON-encoded connection options, for strategies without a typed `options` block. " +
+			"Only the top level keys set here are tracked for drift",
+	},
 	"enabled_clients": {
 		Type:        schema.TypeSet,
 		Elem:        &schema.Schema{Type: schema.TypeString},
@@ -731,8 +778,227 @@ func connectionSchemaUpgradeV1(ctx context.Context, state map[string]interface{}
 	return state, nil
 }
 
+// validateConnectionOptions rejects the keys of the options block which the
+// strategy doesn't read. Keys holding their default value are skipped, as are
+// the values carried over from the state.
+func validateConnectionOptions(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if _, ok := d.GetOk("options"); !ok || !d.NewValueKnown("strategy") {
+		return nil
+	}
+
+	var keys []string
+	for key, s := range connectionSchema["options"].Elem.(*schema.Resource).Schema {
+		k := "options.0." + key
+		v, ok := d.GetOk(k)
+		if !ok {
+			continue
+		}
+		if isDefault, err := isSchemaDefault(s, v); err != nil {
+			return err
+		} else if isDefault {
+			continue
+		}
+		if d.Id() != "" && !d.HasChange(k) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return checkConnectionOptionKeys(d.Get("strategy").(string), keys)
+}
+
+// isSchemaDefault reports whether v is the default value of s.
+func isSchemaDefault(s *schema.Schema, v interface{}) (bool, error) {
+	def := s.Default
+	if s.DefaultFunc != nil {
+		var err error
+		if def, err = s.DefaultFunc(); err != nil {
+			return false, err
+		}
+	}
+	return def != nil && reflect.DeepEqual(v, def), nil
+}
+
+// checkConnectionOptionKeys returns an error naming the strategy if any of
+// the keys isn't one of its options.
+func checkConnectionOptionKeys(strategy string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	allowed := connectionOptionKeys(strategy)
+	if len(allowed) == 0 {
+		return fmt.Errorf("the %q strategy doesn't support the options block, use options_json instead", strategy)
+	}
+
+	var foreign []string
+	for _, key := range keys {
+		if !allowed[key] {
+			foreign = append(foreign, fmt.Sprintf("%q", key))
+		}
+	}
+	if len(foreign) == 0 {
+		return nil
+	}
+
+	sort.Strings(foreign)
+	return fmt.Errorf("options %s don't apply to the %q strategy", strings.Join(foreign, ", "), strategy)
+}
+
+// connectionScriptNames are the custom database action scripts supported by
+// Auth0.
+var connectionScriptNames = []string{
+	"login", "get_user", "create", "verify", "change_password", "delete", "change_email",
+}
+
+// validateConnectionScriptNames checks that the keys of a map of custom
+// database action scripts are script names.
+func validateConnectionScriptNames(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be map", k))
+		return
+	}
+
+	for name := range v {
+		if !inStringSlice(connectionScriptNames, name) {
+			errs = append(errs, fmt.Errorf("%q holds the unknown script %q, expected one of: %s",
+				k, name, strings.Join(connectionScriptNames, ", ")))
+		}
+	}
+	return
+}
+
+// validateConnectionScriptFiles applies validateConnectionScriptNames and
+// checks that the files can be read.
+func validateConnectionScriptFiles(i interface{}, k string) (warnings []string, errs []error) {
+	warnings, errs = validateConnectionScriptNames(i, k)
+	if len(errs) > 0 {
+		return
+	}
+
+	for name, path := range i.(map[string]interface{}) {
+		if _, err := ioutil.ReadFile(path.(string)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to read the %q script of %q: %w", name, k, err))
+		}
+	}
+	return
+}
+
+// validateConnectionCustomScripts checks that a script isn't given both inline
+// and from a file, and that import_mode and enabled_database_customization
+// are consistent with the scripts.
+func validateConnectionCustomScripts(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	scripts := d.Get("options.0.custom_scripts").(map[string]interface{})
+	files := d.Get("options.0.custom_script_files").(map[string]interface{})
+
+	names := make(map[string]bool)
+	for name := range scripts {
+		names[name] = true
+	}
+	for name := range files {
+		if names[name] {
+			return fmt.Errorf("the %q script is set in both options.0.custom_scripts and options.0.custom_script_files", name)
+		}
+		names[name] = true
+	}
+
+	for _, k := range []string{
+		"options.0.custom_scripts",
+		"options.0.custom_script_files",
+		"options.0.enabled_database_customization",
+		"options.0.import_mode",
+	} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	return checkConnectionCustomScripts(
+		names,
+		d.Get("options.0.enabled_database_customization").(bool),
+		d.Get("options.0.import_mode").(bool),
+	)
+}
+
+// checkConnectionCustomScripts checks that import_mode and
+// enabled_database_customization are consistent with the names of the
+// scripts being given. Customization may be enabled without scripts, e.g.
+// when they are managed outside of Terraform.
+func checkConnectionCustomScripts(names map[string]bool, customization, importMode bool) error {
+	if len(names) > 0 && !customization {
+		return fmt.Errorf("options.0.custom_scripts and options.0.custom_script_files " +
+			"require options.0.enabled_database_customization to be true")
+	}
+	if importMode {
+		if !customization {
+			return fmt.Errorf("options.0.import_mode requires options.0.enabled_database_customization to be true")
+		}
+		for _, name := range []string{"login", "get_user"} {
+			if !names[name] {
+				return fmt.Errorf("options.0.import_mode requires the %q script", name)
+			}
+		}
+	}
+	return nil
+}
+
+// customizeConnectionScriptHashes plans an update when the content of a script
+// file no longer matches the script held by Auth0.
+func customizeConnectionScriptHashes(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("options.0.custom_script_files") {
+		return d.SetNewComputed("custom_script_hashes")
+	}
+
+	files := d.Get("options.0.custom_script_files").(map[string]interface{})
+	if len(files) == 0 {
+		if len(d.Get("custom_script_hashes").(map[string]interface{})) > 0 {
+			return d.SetNew("custom_script_hashes", map[string]interface{}{})
+		}
+		return nil
+	}
+
+	hashes := make(map[string]interface{})
+	for name, path := range files {
+		script, err := ioutil.ReadFile(path.(string))
+		if err != nil {
+			return err
+		}
+		hashes[name] = hashConnectionScript(string(script))
+	}
+
+	if !reflect.DeepEqual(hashes, d.Get("custom_script_hashes").(map[string]interface{})) {
+		return d.SetNew("custom_script_hashes", hashes)
+	}
+	return nil
+}
+
+func hashConnectionScript(script string) string {
+	sum := sha256.Sum256([]byte(script))
+	return hex.EncodeToString(sum[:])
+}
+
+// flattenConnectionScriptHashes hashes the scripts loaded from files.
+func flattenConnectionScriptHashes(d ResourceData, options interface{}) map[string]interface{} {
+	o, ok := options.(*management.ConnectionOptions)
+	if !ok {
+		return nil
+	}
+
+	hashes := make(map[string]interface{})
+	for name := range Map(d, "options.0.custom_script_files") {
+		if script, ok := o.CustomScripts[name].(string); ok {
+			hashes[name] = hashConnectionScript(script)
+		}
+	}
+	return hashes
+}
+
 func createConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := expandConnection(d)
+	c, err := expandConnection(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	api := m.(*management.Management)
 	if err := api.Connection.Create(c, management.Context(ctx)); err != nil {
 		return diag.FromErr(err)
@@ -753,16 +1019,38 @@ func readConnection(ctx context.Context, d *schema.ResourceData, m interface{})
 	_ = d.Set("display_name", c.DisplayName)
 	_ = d.Set("is_domain_connection", c.IsDomainConnection)
 	_ = d.Set("strategy", c.Strategy)
-	_ = d.Set("options", flattenConnectionOptions(d, c.Options))
+
+	// The options of the strategies without a typed options block are kept in
+	// options_json, unless they are configured with an options block, which
+	// is then left as it is.
+	options := flattenConnectionOptions(d, c.Options)
+	_, hasOptionsJSON := d.GetOk("options_json")
+	hasOptions := len(d.Get("options").([]interface{})) > 0
+	switch {
+	case hasOptionsJSON || (options == nil && !hasOptions):
+		optionsJSON, err := flattenConnectionOptionsJSON(d, c.Options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_ = d.Set("options_json", optionsJSON)
+		_ = d.Set("options", nil)
+	case options != nil:
+		_ = d.Set("options", options)
+	}
+	_ = d.Set("custom_script_hashes", flattenConnectionScriptHashes(d, c.Options))
+
 	_ = d.Set("enabled_clients", c.EnabledClients)
 	_ = d.Set("realms", c.Realms)
 	return nil
 }
 
 func updateConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := expandConnection(d)
+	c, err := expandConnection(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	api := m.(*management.Management)
-	err := api.Connection.Update(d.Id(), c, management.Context(ctx))
+	err = api.Connection.Update(d.Id(), c, management.Context(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
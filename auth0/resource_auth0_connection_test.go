@@ -1,14 +1,20 @@
 package auth0
 
 import (
+	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"gopkg.in/auth0.v5/management"
 )
 
@@ -101,7 +107,7 @@ func TestAccConnection(t *testing.T) {
 							max = 40
 						}
 					}
-					enabled_database_customization = false
+					enabled_database_customization = true
 					brute_force_protection = true
 					import_mode = false
 					requires_username = true
@@ -127,7 +133,7 @@ func TestAccConnection(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.password_no_personal_info.0.enable", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.password_dictionary.0.enable", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.password_complexity_options.0.min_length", "6"),
-					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.enabled_database_customization", "false"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.enabled_database_customization", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.brute_force_protection", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.import_mode", "false"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.disable_signup", "false"),
@@ -156,7 +162,7 @@ func TestAccConnection(t *testing.T) {
 					password_no_personal_info {
 						enable = true
 					}
-					enabled_database_customization = false
+					enabled_database_customization = true
 					brute_force_protection = false
 					import_mode = false
 					disable_signup = false
@@ -1014,6 +1020,342 @@ resource "auth0_connection" "github" {
 	})
 }
 
+func TestAccConnectionADFS(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "adfs" {
+	name = "Acceptance-Test-ADFS-{{.random}}"
+	strategy = "adfs"
+	options {
+		adfs_server = "https://adfs.example.com/FederationMetadata/2007-06/FederationMetadata.xml"
+		tenant_domain = "example.com"
+		domain_aliases = [ "example.com", "api.example.com" ]
+		set_user_root_attributes = "on_first_login"
+	}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "strategy", "adfs"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.adfs_server", "https://adfs.example.com/FederationMetadata/2007-06/FederationMetadata.xml"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.tenant_domain", "example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.domain_aliases.#", "2"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.set_user_root_attributes", "on_first_login"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionGoogleApps(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "google_apps" {
+	name = "Acceptance-Test-Google-Apps-{{.random}}"
+	strategy = "google-apps"
+	options {
+		client_id = "client-id"
+		client_secret = "client-secret"
+		domain = "example.com"
+		tenant_domain = "example.com"
+		api_enable_users = true
+		scopes = [ "ext_groups", "ext_profile" ]
+	}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.google_apps", "strategy", "google-apps"),
+					resource.TestCheckResourceAttr("auth0_connection.google_apps", "options.0.client_id", "client-id"),
+					resource.TestCheckResourceAttr("auth0_connection.google_apps", "options.0.domain", "example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.google_apps", "options.0.tenant_domain", "example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.google_apps", "options.0.api_enable_users", "true"),
+					resource.TestCheckResourceAttr("auth0_connection.google_apps", "options.0.scopes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionTwitter(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "twitter" {
+	name = "Acceptance-Test-Twitter-{{.random}}"
+	strategy = "twitter"
+	options {
+		client_id = "consumer-key"
+		client_secret = "consumer-secret"
+		set_user_root_attributes = "on_each_login"
+	}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.twitter", "strategy", "twitter"),
+					resource.TestCheckResourceAttr("auth0_connection.twitter", "options.0.client_id", "consumer-key"),
+					resource.TestCheckResourceAttr("auth0_connection.twitter", "options.0.client_secret", "consumer-secret"),
+					resource.TestCheckResourceAttr("auth0_connection.twitter", "options.0.set_user_root_attributes", "on_each_login"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionPingFederate(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "ping_federate" {
+	name = "Acceptance-Test-PingFederate-{{.random}}"
+	strategy = "pingfederate"
+	options {
+		ping_federate_base_url = "https://ping.example.com"
+		signing_cert = "MIIC8jCCAdqgAwIBAgIQLzdM..."
+		tenant_domain = "example.com"
+		sign_saml_request = true
+		signature_algorithm = "rsa-sha256"
+		digest_algorithm = "sha256"
+	}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.ping_federate", "strategy", "pingfederate"),
+					resource.TestCheckResourceAttr("auth0_connection.ping_federate", "options.0.ping_federate_base_url", "https://ping.example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.ping_federate", "options.0.tenant_domain", "example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.ping_federate", "options.0.sign_saml_request", "true"),
+					resource.TestCheckResourceAttr("auth0_connection.ping_federate", "options.0.signature_algorithm", "rsa-sha256"),
+					resource.TestCheckResourceAttr("auth0_connection.ping_federate", "options.0.digest_algorithm", "sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionOkta(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "okta" {
+	name = "Acceptance-Test-Okta-{{.random}}"
+	strategy = "okta"
+	options {
+		client_id = "client-id"
+		client_secret = "client-secret"
+		domain = "example.okta.com"
+		issuer = "https://example.okta.com"
+		jwks_uri = "https://example.okta.com/oauth2/v1/keys"
+		token_endpoint = "https://example.okta.com/oauth2/v1/token"
+		userinfo_endpoint = "https://example.okta.com/oauth2/v1/userinfo"
+		authorization_endpoint = "https://example.okta.com/oauth2/v1/authorize"
+		scopes = [ "openid", "profile", "email" ]
+	}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.okta", "strategy", "okta"),
+					resource.TestCheckResourceAttr("auth0_connection.okta", "options.0.client_id", "client-id"),
+					resource.TestCheckResourceAttr("auth0_connection.okta", "options.0.domain", "example.okta.com"),
+					resource.TestCheckResourceAttr("auth0_connection.okta", "options.0.issuer", "https://example.okta.com"),
+					resource.TestCheckResourceAttr("auth0_connection.okta", "options.0.scopes.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionDropbox(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "dropbox" {
+	name = "Acceptance-Test-Dropbox-{{.random}}"
+	strategy = "dropbox"
+	options {
+		client_id = "client-id"
+		client_secret = "client-secret"
+		scopes = [ "files.metadata.read" ]
+	}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.dropbox", "strategy", "dropbox"),
+					resource.TestCheckResourceAttr("auth0_connection.dropbox", "options.0.client_id", "client-id"),
+					resource.TestCheckResourceAttr("auth0_connection.dropbox", "options.0.client_secret", "client-secret"),
+					resource.TestCheckResourceAttr("auth0_connection.dropbox", "options.0.scopes.#", "1"),
+					resource.TestCheckResourceAttr("auth0_connection.dropbox", "options_json", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionEmptyOptions(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "amazon" {
+	name = "Acceptance-Test-Amazon-{{.random}}"
+	strategy = "amazon"
+	options {}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.amazon", "strategy", "amazon"),
+					resource.TestCheckResourceAttr("auth0_connection.amazon", "options.#", "1"),
+					resource.TestCheckResourceAttr("auth0_connection.amazon", "options_json", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionOptionsJSON(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "amazon" {
+	name = "Acceptance-Test-Amazon-{{.random}}"
+	strategy = "amazon"
+	options_json = jsonencode({
+		client_id = "client-id"
+		client_secret = "client-secret"
+	})
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.amazon", "strategy", "amazon"),
+					resource.TestCheckResourceAttr("auth0_connection.amazon", "options.#", "0"),
+					resource.TestCheckResourceAttr("auth0_connection.amazon", "options_json", `{"client_id":"client-id","client_secret":"client-secret"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeConnectionOptions(t *testing.T) {
+
+	options := map[string]interface{}{
+		"pingFederateBaseUrl": "https://ping.example.com",
+		"signSAMLRequest":     true,
+		"domain_aliases":      []interface{}{"example.com"},
+	}
+
+	o, ok := decodeConnectionOptions("pingfederate", options).(*connectionOptionsPingFederate)
+	if !ok {
+		t.Fatalf("Expected options to be decoded as pingfederate options")
+	}
+	if o.PingFederateBaseURL == nil || *o.PingFederateBaseURL != "https://ping.example.com" {
+		t.Errorf("Unexpected base url %v", o.PingFederateBaseURL)
+	}
+	if o.SignSAMLRequest == nil || !*o.SignSAMLRequest {
+		t.Errorf("Expected SAML requests to be signed")
+	}
+	if !reflect.DeepEqual(o.DomainAliases, []interface{}{"example.com"}) {
+		t.Errorf("Unexpected domain aliases %v", o.DomainAliases)
+	}
+
+	s, ok := decodeConnectionOptions("paypal", map[string]interface{}{
+		"client_id": "client-id",
+		"scope":     "openid email",
+	}).(*connectionOptionsSocial)
+	if !ok {
+		t.Fatalf("Expected options to be decoded as social options")
+	}
+	if !reflect.DeepEqual(s.Scopes(), []string{"openid", "email"}) {
+		t.Errorf("Unexpected scopes %v", s.Scopes())
+	}
+	s.SetScopes(false, "openid")
+	s.SetScopes(true, "profile")
+	if *s.Scope != "email profile" {
+		t.Errorf("Unexpected scope %q", *s.Scope)
+	}
+
+	if _, ok := decodeConnectionOptions("amazon", options).(map[string]interface{}); !ok {
+		t.Errorf("Expected options of an untyped strategy to be left as is")
+	}
+}
+
+func TestFlattenConnectionOptionsJSON(t *testing.T) {
+
+	options := map[string]interface{}{
+		"client_id":     "client-id",
+		"client_secret": "client-secret",
+		"scope":         []interface{}{"email"},
+	}
+
+	d := schema.TestResourceDataRaw(t, newConnection().Schema, map[string]interface{}{
+		"strategy": "amazon",
+	})
+	v, err := flattenConnectionOptionsJSON(d, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"client_id":"client-id","client_secret":"client-secret","scope":["email"]}`; v != expected {
+		t.Errorf("Expected all options to be kept on import, got %s", v)
+	}
+
+	d = schema.TestResourceDataRaw(t, newConnection().Schema, map[string]interface{}{
+		"strategy":     "amazon",
+		"options_json": `{"client_id": "old"}`,
+	})
+	v, err = flattenConnectionOptionsJSON(d, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"client_id":"client-id"}`; v != expected {
+		t.Errorf("Expected only the configured options to be kept, got %s", v)
+	}
+}
+
 func TestAccConnectionWindowslive(t *testing.T) {
 
 	rand := random.String(6)
@@ -1405,3 +1747,287 @@ EOF
 // 		},
 // 	})
 // }
+
+func TestConnectionOptionKeys(t *testing.T) {
+	for strategy, expected := range map[string][]string{
+		"twitter": {"client_id", "client_secret", "non_persistent_attrs", "set_user_root_attributes"},
+		"adfs":    {"adfs_server", "api_enable_users", "domain_aliases", "icon_url", "non_persistent_attrs", "set_user_root_attributes", "tenant_domain"},
+		"dropbox": {"client_id", "client_secret", "non_persistent_attrs", "scopes", "set_user_root_attributes"},
+		"amazon":  nil,
+	} {
+		var keys []string
+		for key := range connectionOptionKeys(strategy) {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		if !reflect.DeepEqual(keys, expected) {
+			t.Errorf("Expected the %s options to be %v, got %v", strategy, expected, keys)
+		}
+	}
+}
+
+func TestCheckConnectionOptionKeys(t *testing.T) {
+	for _, test := range []struct {
+		strategy string
+		keys     []string
+		err      string
+	}{
+		{
+			strategy: "google-oauth2",
+			keys:     []string{"client_id", "scopes"},
+		},
+		{
+			strategy: "google-oauth2",
+			keys:     []string{"tenant_domain", "client_id", "adfs_server"},
+			err:      `options "adfs_server", "tenant_domain" don't apply to the "google-oauth2" strategy`,
+		},
+		{
+			strategy: "auth0",
+			keys:     []string{"twilio_sid"},
+			err:      `options "twilio_sid" don't apply to the "auth0" strategy`,
+		},
+		{
+			strategy: "amazon",
+			keys:     []string{"client_id"},
+			err:      `the "amazon" strategy doesn't support the options block, use options_json instead`,
+		},
+		{
+			strategy: "amazon",
+		},
+	} {
+		err := checkConnectionOptionKeys(test.strategy, test.keys)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("Unexpected error for %s: %s", test.strategy, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != test.err {
+			t.Errorf("Expected error %q, got %v", test.err, err)
+		}
+	}
+}
+
+func TestIsSchemaDefault(t *testing.T) {
+	twilioToken := connectionSchema["options"].Elem.(*schema.Resource).Schema["twilio_token"]
+
+	os.Setenv("TWILIO_TOKEN", "token")
+	defer os.Unsetenv("TWILIO_TOKEN")
+
+	for _, test := range []struct {
+		schema   *schema.Schema
+		value    interface{}
+		expected bool
+	}{
+		{schema: twilioToken, value: "token", expected: true},
+		{schema: twilioToken, value: "other-token", expected: false},
+		{schema: &schema.Schema{Type: schema.TypeBool, Default: true}, value: true, expected: true},
+		{schema: &schema.Schema{Type: schema.TypeBool, Default: true}, value: false, expected: false},
+		{schema: &schema.Schema{Type: schema.TypeString}, value: "", expected: false},
+	} {
+		isDefault, err := isSchemaDefault(test.schema, test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if isDefault != test.expected {
+			t.Errorf("Expected %v to be default: %t, got %t", test.value, test.expected, isDefault)
+		}
+	}
+}
+
+func TestAccConnectionForeignOptions(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "google_oauth2" {
+	name = "Acceptance-Test-Google-OAuth2-{{.random}}"
+	strategy = "google-oauth2"
+	options {
+		client_id = "client-id"
+		tenant_domain = "example.com"
+	}
+}
+`, rand),
+				ExpectError: regexp.MustCompile(`options "tenant_domain" don't apply to the "google-oauth2" strategy`),
+			},
+			{
+				Config: random.Template(`
+
+resource "auth0_connection" "google_oauth2" {
+	name = "Acceptance-Test-Google-OAuth2-{{.random}}"
+	strategy = "google-oauth2"
+	options {
+		client_id = "client-id"
+		twilio_token = "token"
+	}
+}
+`, rand),
+				ExpectError: regexp.MustCompile(`options "twilio_token" don't apply to the "google-oauth2" strategy`),
+			},
+		},
+	})
+}
+
+func TestConnectionScriptFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth0-connection")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	script := "function login(email, password, callback) { callback(null) }"
+	path := filepath.Join(dir, "login.js")
+	if err := ioutil.WriteFile(path, []byte(script), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]interface{}{"login": path}
+	if _, errs := validateConnectionScriptFiles(files, "custom_script_files"); len(errs) > 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	missing := map[string]interface{}{"login": filepath.Join(dir, "missing.js")}
+	if _, errs := validateConnectionScriptFiles(missing, "custom_script_files"); len(errs) != 1 {
+		t.Errorf("expected an error for a missing file, got %v", errs)
+	}
+	unknown := map[string]interface{}{"signin": path}
+	if _, errs := validateConnectionScriptFiles(unknown, "custom_script_files"); len(errs) != 1 {
+		t.Errorf("expected an error for an unknown script, got %v", errs)
+	}
+
+	d := schema.TestResourceDataRaw(t, newConnection().Schema, map[string]interface{}{
+		"name":     "Test-Connection",
+		"strategy": "auth0",
+		"options": []interface{}{
+			map[string]interface{}{
+				"enabled_database_customization": true,
+				"custom_scripts":                 map[string]interface{}{"get_user": "function getUser() {}"},
+				"custom_script_files":            files,
+			},
+		},
+	})
+
+	c, err := expandConnection(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"get_user": "function getUser() {}", "login": script}
+	if scripts := c.Options.(*management.ConnectionOptions).CustomScripts; !reflect.DeepEqual(scripts, expected) {
+		t.Errorf("Expected the scripts %v, got %v", expected, scripts)
+	}
+
+	hashes := flattenConnectionScriptHashes(d, c.Options)
+	if !reflect.DeepEqual(hashes, map[string]interface{}{"login": hashConnectionScript(script)}) {
+		t.Errorf("Expected only the script loaded from a file to be hashed, got %v", hashes)
+	}
+	if scripts := flattenConnectionCustomScripts(d, expected); !reflect.DeepEqual(scripts, map[string]interface{}{"get_user": "function getUser() {}"}) {
+		t.Errorf("Expected the scripts loaded from files to be left out, got %v", scripts)
+	}
+}
+
+func TestCheckConnectionCustomScripts(t *testing.T) {
+	for _, test := range []struct {
+		names         map[string]bool
+		customization bool
+		importMode    bool
+		err           string
+	}{
+		{
+			names:         map[string]bool{"get_user": true},
+			customization: true,
+		},
+		{
+			names:         map[string]bool{"login": true, "get_user": true},
+			customization: true,
+			importMode:    true,
+		},
+		{
+			customization: true,
+		},
+		{},
+		{
+			names: map[string]bool{"get_user": true},
+			err:   "options.0.custom_scripts and options.0.custom_script_files require options.0.enabled_database_customization to be true",
+		},
+		{
+			importMode: true,
+			err:        "options.0.import_mode requires options.0.enabled_database_customization to be true",
+		},
+		{
+			names:         map[string]bool{"login": true},
+			customization: true,
+			importMode:    true,
+			err:           `options.0.import_mode requires the "get_user" script`,
+		},
+	} {
+		err := checkConnectionCustomScripts(test.names, test.customization, test.importMode)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != test.err {
+			t.Errorf("Expected error %q, got %v", test.err, err)
+		}
+	}
+}
+
+func TestAccConnectionCustomScriptFiles(t *testing.T) {
+
+	rand := random.String(6)
+
+	dir, err := ioutil.TempDir("", "auth0-connection")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "get_user.js")
+	write := func(script string) func() {
+		return func() {
+			if err := ioutil.WriteFile(path, []byte(script), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	write("function getUser(email, callback) { callback(null) }")()
+
+	config := random.TemplateMap(`
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Connection-{{.random}}"
+	strategy = "auth0"
+	options {
+		enabled_database_customization = true
+		custom_script_files = {
+			get_user = "{{.path}}"
+		}
+	}
+}
+`, map[string]string{"random": rand, "path": path})
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.custom_script_files.get_user", path),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "custom_script_hashes.get_user",
+						hashConnectionScript("function getUser(email, callback) { callback(null) }")),
+				),
+			},
+			{
+				PreConfig: write("function getUser(email, callback) { callback(new Error('Whoops!')) }"),
+				Config:    config,
+				Check: resource.TestCheckResourceAttr("auth0_connection.my_connection", "custom_script_hashes.get_user",
+					hashConnectionScript("function getUser(email, callback) { callback(new Error('Whoops!')) }")),
+			},
+		},
+	})
+}
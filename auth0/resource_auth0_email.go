@@ -13,7 +13,8 @@ import (
 )
 
 func newEmail() *schema.Resource {
-	emailProvider := []string{"mandrill", "sendgrid", "sparkpost", "mailgun", "ses", "smtp"}
+	emailProvider := []string{"mandrill", "sendgrid", "sparkpost", "mailgun", "ses", "smtp", "ms365", "azure_cs",
+		"custom"}
 	return &schema.Resource{
 
 		CreateContext: createEmail,
@@ -202,14 +203,93 @@ authentication workflows through the supported high-volume email service of your
 					},
 				},
 			},
+			"ms365": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Description:  "Configuration for the Microsoft 365 email integration",
+				MaxItems:     1,
+				ExactlyOneOf: emailProvider,
+				ForceNew:     true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tenant_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Microsoft 365 Tenant ID",
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Microsoft 365 Client ID",
+						},
+						"client_secret": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							ForceNew:    true,
+							Description: "Microsoft 365 Client Secret",
+						},
+					},
+				},
+			},
+			"azure_cs": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Description:  "Configuration for the Azure Communication Services email integration",
+				MaxItems:     1,
+				ExactlyOneOf: emailProvider,
+				ForceNew:     true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_string": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							ForceNew:    true,
+							Description: "Azure Communication Services connection string",
+						},
+					},
+				},
+			},
+			"custom": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Configuration for a custom email provider. Emails will be sent by the action bound " +
+					"to the `custom-email-provider` trigger",
+				MaxItems:     1,
+				ExactlyOneOf: emailProvider,
+				ForceNew:     true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+			},
 		},
 	}
 }
 
+// email extends management.Email with the credentials of the providers which
+// are not supported by the auth0 sdk yet.
+type email struct {
+	management.Email
+	Credentials *emailCredentials `json:"credentials,omitempty"`
+}
+
+type emailCredentials struct {
+	management.EmailCredentials
+	// Microsoft 365 Tenant ID
+	TenantID *string `json:"tenantId,omitempty"`
+	// Microsoft 365 Client ID
+	ClientID *string `json:"clientId,omitempty"`
+	// Microsoft 365 Client Secret
+	ClientSecret *string `json:"clientSecret,omitempty"`
+	// Azure Communication Services connection string
+	ConnectionString *string `json:"connectionString,omitempty"`
+}
+
 func createEmail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	e := buildEmail(d)
 	api := m.(*management.Management)
-	if err := api.Email.Create(e, management.Context(ctx)); err != nil {
+	if err := api.Request("POST", api.URI("emails", "provider"), e, management.Context(ctx)); err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(auth0.StringValue(e.Name))
@@ -218,7 +298,9 @@ func createEmail(ctx context.Context, d *schema.ResourceData, m interface{}) dia
 
 func readEmail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*management.Management)
-	e, err := api.Email.Read(management.Context(ctx))
+	var e *email
+	err := api.Request("GET", api.URI("emails", "provider"), &e, management.Context(ctx),
+		management.WithFields("name", "enabled", "default_from_address", "credentials", "settings"))
 	if err != nil {
 		return flow.DefaultManagementError(err, d)
 	}
@@ -227,55 +309,84 @@ func readEmail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.
 	_ = d.Set("enabled", e.Enabled)
 	_ = d.Set("default_from_address", e.DefaultFromAddress)
 
-	switch *e.Name {
-	case "mandrill":
-		_ = d.Set("mandrill", flattenMap(map[string]interface{}{
-			"api_key": d.Get("mandrill.0.api_key"),
-		}))
-	case "sendgrid":
-		_ = d.Set("sendgrid", flattenMap(map[string]interface{}{
-			"api_key": d.Get("sendgrid.0.api_key"),
-		}))
+	if key, value := flattenEmailProvider(d, e); key != "" {
+		_ = d.Set(key, value)
+	}
+
+	return nil
+}
+
+// flattenEmailProvider returns the key and the value of the provider block
+// matching the email provider. Most credentials are write only on the Auth0
+// side, so they are retained from the state.
+func flattenEmailProvider(d ResourceData, e *email) (string, interface{}) {
+	credentials := e.Credentials
+	if credentials == nil {
+		credentials = &emailCredentials{}
+	}
+
+	switch name := auth0.StringValue(e.Name); name {
+	case "mandrill", "sendgrid":
+		return name, flattenMap(map[string]interface{}{
+			"api_key": d.Get(name + ".0.api_key"),
+		})
 	case "ses":
-		_ = d.Set("ses", flattenMap(map[string]interface{}{
+		return name, flattenMap(map[string]interface{}{
 			"access_key_id":     d.Get("ses.0.access_key_id"),
 			"secret_access_key": d.Get("ses.0.secret_access_key"),
-			"region":            e.Credentials.Region,
-		}))
+			"region":            credentials.Region,
+		})
 	case "sparkpost":
 		dataMap := map[string]interface{}{
 			"api_key": d.Get("sparkpost.0.api_key"),
 		}
-		if e.Credentials.Region != nil {
-			dataMap["region"] = e.Credentials.Region
+		if credentials.Region != nil {
+			dataMap["region"] = credentials.Region
 		}
-		_ = d.Set("sparkpost", flattenMap(dataMap))
+		return name, flattenMap(dataMap)
 	case "mailgun":
 		dataMap := map[string]interface{}{
 			"api_key": d.Get("mailgun.0.api_key"),
 			"domain":  d.Get("mailgun.0.domain"),
 		}
-		if e.Credentials.Region != nil {
-			dataMap["region"] = e.Credentials.Region
+		if credentials.Region != nil {
+			dataMap["region"] = credentials.Region
 		}
-		_ = d.Set("mailgun", flattenMap(dataMap))
+		return name, flattenMap(dataMap)
 	case "smtp":
-		dataMap := map[string]interface{}{
+		return name, flattenMap(map[string]interface{}{
 			"pass": d.Get("smtp.0.pass"),
-			"host": e.Credentials.GetSMTPHost(),
-			"port": e.Credentials.GetSMTPPort(),
-			"user": e.Credentials.GetSMTPUser(),
+			"host": credentials.GetSMTPHost(),
+			"port": credentials.GetSMTPPort(),
+			"user": credentials.GetSMTPUser(),
+		})
+	case "ms365":
+		dataMap := map[string]interface{}{
+			"tenant_id":     d.Get("ms365.0.tenant_id"),
+			"client_id":     d.Get("ms365.0.client_id"),
+			"client_secret": d.Get("ms365.0.client_secret"),
 		}
-		_ = d.Set("smtp", flattenMap(dataMap))
+		if credentials.TenantID != nil {
+			dataMap["tenant_id"] = credentials.TenantID
+		}
+		if credentials.ClientID != nil {
+			dataMap["client_id"] = credentials.ClientID
+		}
+		return name, flattenMap(dataMap)
+	case "azure_cs":
+		return name, flattenMap(map[string]interface{}{
+			"connection_string": d.Get("azure_cs.0.connection_string"),
+		})
+	case "custom":
+		return name, []interface{}{map[string]interface{}{}}
 	}
-
-	return nil
+	return "", nil
 }
 
 func updateEmail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	e := buildEmail(d)
 	api := m.(*management.Management)
-	err := api.Email.Update(e, management.Context(ctx))
+	err := api.Request("PATCH", api.URI("emails", "provider"), e, management.Context(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -291,62 +402,89 @@ func deleteEmail(ctx context.Context, d *schema.ResourceData, m interface{}) dia
 	return nil
 }
 
-func buildEmail(d *schema.ResourceData) *management.Email {
-	e := &management.Email{
-		Enabled:            Bool(d, "enabled"),
-		DefaultFromAddress: String(d, "default_from_address"),
+func buildEmail(d ResourceData) *email {
+	e := &email{
+		Email: management.Email{
+			Enabled:            Bool(d, "enabled"),
+			DefaultFromAddress: String(d, "default_from_address"),
+		},
 	}
 
 	List(d, "mandrill").Elem(func(d ResourceData) {
 		e.Name = auth0.String("mandrill")
-		e.Credentials = &management.EmailCredentials{
-			APIKey: String(d, "api_key"),
+		e.Credentials = &emailCredentials{
+			EmailCredentials: management.EmailCredentials{
+				APIKey: String(d, "api_key"),
+			},
 		}
 	})
 
 	List(d, "sendgrid").Elem(func(d ResourceData) {
 		e.Name = auth0.String("sendgrid")
-		e.Credentials = &management.EmailCredentials{
-			APIKey: String(d, "api_key"),
+		e.Credentials = &emailCredentials{
+			EmailCredentials: management.EmailCredentials{
+				APIKey: String(d, "api_key"),
+			},
 		}
 	})
 	List(d, "sparkpost").Elem(func(d ResourceData) {
 		e.Name = auth0.String("sparkpost")
-		e.Credentials = &management.EmailCredentials{
-			APIKey: String(d, "api_key"),
-		}
-		if region, ok := d.GetOk("region"); ok {
-			e.Credentials.Region = auth0.String(region.(string))
+		e.Credentials = &emailCredentials{
+			EmailCredentials: management.EmailCredentials{
+				APIKey: String(d, "api_key"),
+				Region: String(d, "region"),
+			},
 		}
 	})
 	List(d, "mailgun").Elem(func(d ResourceData) {
 		e.Name = auth0.String("mailgun")
-		e.Credentials = &management.EmailCredentials{
-			APIKey: String(d, "api_key"),
-			Domain: String(d, "domain"),
-		}
-		if region, ok := d.GetOk("region"); ok {
-			e.Credentials.Region = auth0.String(region.(string))
+		e.Credentials = &emailCredentials{
+			EmailCredentials: management.EmailCredentials{
+				APIKey: String(d, "api_key"),
+				Domain: String(d, "domain"),
+				Region: String(d, "region"),
+			},
 		}
 	})
 
 	List(d, "ses").Elem(func(d ResourceData) {
 		e.Name = auth0.String("ses")
-		e.Credentials = &management.EmailCredentials{
-			AccessKeyID:     String(d, "access_key_id"),
-			SecretAccessKey: String(d, "secret_access_key"),
-			Region:          String(d, "region"),
+		e.Credentials = &emailCredentials{
+			EmailCredentials: management.EmailCredentials{
+				AccessKeyID:     String(d, "access_key_id"),
+				SecretAccessKey: String(d, "secret_access_key"),
+				Region:          String(d, "region"),
+			},
 		}
 	})
 	List(d, "smtp").Elem(func(d ResourceData) {
 		e.Name = auth0.String("smtp")
-		e.Credentials = &management.EmailCredentials{
-			SMTPHost: String(d, "host"),
-			SMTPPort: Int(d, "port"),
-			SMTPUser: String(d, "user"),
-			SMTPPass: String(d, "pass"),
+		e.Credentials = &emailCredentials{
+			EmailCredentials: management.EmailCredentials{
+				SMTPHost: String(d, "host"),
+				SMTPPort: Int(d, "port"),
+				SMTPUser: String(d, "user"),
+				SMTPPass: String(d, "pass"),
+			},
 		}
 	})
+	List(d, "ms365").Elem(func(d ResourceData) {
+		e.Name = auth0.String("ms365")
+		e.Credentials = &emailCredentials{
+			TenantID:     String(d, "tenant_id"),
+			ClientID:     String(d, "client_id"),
+			ClientSecret: String(d, "client_secret"),
+		}
+	})
+	List(d, "azure_cs").Elem(func(d ResourceData) {
+		e.Name = auth0.String("azure_cs")
+		e.Credentials = &emailCredentials{
+			ConnectionString: String(d, "connection_string"),
+		}
+	})
+	List(d, "custom").Elem(func(d ResourceData) {
+		e.Name = auth0.String("custom")
+	})
 
 	return e
 }
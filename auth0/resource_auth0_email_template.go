@@ -2,9 +2,12 @@ package auth0
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/liquid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,6 +21,7 @@ func newEmailTemplate() *schema.Resource {
 		ReadContext:   readEmailTemplate,
 		UpdateContext: updateEmailTemplate,
 		DeleteContext: deleteEmailTemplate,
+		CustomizeDiff: validateEmailTemplateSyntax,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -72,9 +76,11 @@ and sender identities of emails sent by Auth0. Used in conjunction with configur
 					"com/docs/email/templates#common-variables)",
 			},
 			"syntax": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Syntax of the template body. You can use either text or HTML + Liquid syntax",
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Syntax of the template body. Options include `liquid` (HTML + Liquid) and `text`. " +
+					"The liquid of the `body` and `subject` of `liquid` templates is validated at plan time",
+				ValidateFunc: validation.StringInSlice([]string{"liquid", "text"}, false),
 			},
 			"url_lifetime_in_seconds": {
 				Type:        schema.TypeInt,
@@ -173,3 +179,69 @@ func buildEmailTemplate(d *schema.ResourceData) *management.EmailTemplate {
 	log.Printf("[DEBUG] Template: %s", t)
 	return t
 }
+
+// emailTemplateVariables are the common variables Auth0 provides to the email
+// templates.
+var emailTemplateVariables = []string{
+	"application", "code", "connection", "friendly_name", "inviter", "organization",
+	"request_language", "support_email", "support_url", "tenant", "url", "user",
+}
+
+// validateEmailTemplateSyntax validates the liquid of the body and subject of
+// liquid templates, text templates may hold braces of their own. The warnings
+// about unknown variables are logged, a CustomizeDiff can't report them.
+func validateEmailTemplateSyntax(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("syntax") || d.Get("syntax").(string) != "liquid" {
+		return nil
+	}
+	for _, k := range []string{"body", "subject"} {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+		warnings, errs := validateEmailTemplateLiquid(d.Get(k), k)
+		for _, warning := range warnings {
+			log.Printf("[WARN]: %s", warning)
+		}
+		if len(errs) > 0 {
+			return errs[0]
+		}
+	}
+	return nil
+}
+
+// validateEmailTemplateLiquid parses the liquid of an email template, and
+// warns about the variables which are not among the common variables, since
+// they would render as an empty string.
+func validateEmailTemplateLiquid(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if err := liquid.Validate(v); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid liquid template: %w", k, err))
+		return
+	}
+	variables, err := liquid.Variables(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid liquid template: %w", k, err))
+		return
+	}
+	for _, name := range variables {
+		if !inStringSlice(emailTemplateVariables, name) {
+			warnings = append(warnings, fmt.Sprintf("%q uses the unknown variable %q, known variables are: %s",
+				k, name, strings.Join(emailTemplateVariables, ", ")))
+		}
+	}
+	return
+}
+
+func inStringSlice(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
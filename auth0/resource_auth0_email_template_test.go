@@ -1,9 +1,13 @@
 package auth0
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/management"
 )
@@ -69,3 +73,70 @@ func TestAccEmailTemplate(t *testing.T) {
 		},
 	})
 }
+
+func TestValidateEmailTemplateLiquid(t *testing.T) {
+	for _, tc := range []struct {
+		body     string
+		warnings []string
+		errs     []string
+	}{
+		{
+			body: `<h1>Welcome {{ user.name | default: user.email }}</h1>{% if application.name %}{{ application.name }}{% endif %}`,
+		},
+		{
+			body: `{% assign name = user.given_name %}{% for i in (1..3) %}{{ name }}{{ i }}{% endfor %}`,
+		},
+		{
+			body:     `Hello {{ usr.name }}`,
+			warnings: []string{`"body" uses the unknown variable "usr", known variables are: application, code, connection, friendly_name, inviter, organization, request_language, support_email, support_url, tenant, url, user`},
+		},
+		{
+			body: `{% if user.name %}Hello`,
+			errs: []string{`"body" is not a valid liquid template: line 1: "if" is never closed by "endif"`},
+		},
+	} {
+		warnings, errs := validateEmailTemplateLiquid(tc.body, "body")
+		if strings.Join(warnings, "\n") != strings.Join(tc.warnings, "\n") {
+			t.Errorf("expected warnings %v, got %v", tc.warnings, warnings)
+		}
+		if len(errs) != len(tc.errs) {
+			t.Errorf("expected %d errors, got %v", len(tc.errs), errs)
+			continue
+		}
+		for i := range errs {
+			if errs[i].Error() != tc.errs[i] {
+				t.Errorf("expected error %q, got %q", tc.errs[i], errs[i])
+			}
+		}
+	}
+}
+
+func TestValidateEmailTemplateSyntax(t *testing.T) {
+	for _, tc := range []struct {
+		syntax string
+		err    string
+	}{
+		{syntax: "text"},
+		{syntax: "liquid", err: `"body" is not a valid liquid template: line 1: "if" is never closed by "endif"`},
+	} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"template": "welcome_email",
+			"body":     "Use {% if %} in the template",
+			"from":     "me@example.com",
+			"subject":  "Welcome {{",
+			"syntax":   tc.syntax,
+			"enabled":  true,
+		})
+		_, err := schema.InternalMap(newEmailTemplate().Schema).Diff(
+			context.Background(), nil, config, validateEmailTemplateSyntax, nil, false)
+		if tc.err == "" {
+			if err != nil {
+				t.Errorf("unexpected error for the %s syntax: %s", tc.syntax, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("expected error %q, got %v", tc.err, err)
+		}
+	}
+}
@@ -1,9 +1,14 @@
 package auth0
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
 )
 
 func init() {
@@ -176,6 +181,176 @@ func TestAccEmail_Common(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "smtp.0.pass", "qwerty"),
 				),
 			},
+			{
+				// language=HCL
+				Config: `
+			    resource "auth0_email" "my_email_provider" {
+			        enabled = true
+			        default_from_address = "accounts@example.com"
+			        ms365 {
+			        	tenant_id = "tenant-id"
+						client_id = "client-id"
+						client_secret = "client-secret"
+			        }
+			    }
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "ms365.0.tenant_id", "tenant-id"),
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "ms365.0.client_id", "client-id"),
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "ms365.0.client_secret", "client-secret"),
+				),
+			},
+			{
+				// language=HCL
+				Config: `
+			    resource "auth0_email" "my_email_provider" {
+			        enabled = true
+			        default_from_address = "accounts@example.com"
+			        azure_cs {
+			        	connection_string = "endpoint=https://example.communication.azure.com/;accesskey=xxxxx"
+			        }
+			    }
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "azure_cs.0.connection_string",
+						"endpoint=https://example.communication.azure.com/;accesskey=xxxxx"),
+				),
+			},
 		},
 	})
 }
+
+func TestBuildEmail(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+	}{
+		{
+			name: "smtp",
+			raw: map[string]interface{}{
+				"enabled":              true,
+				"default_from_address": "accounts@example.com",
+				"smtp": []interface{}{map[string]interface{}{
+					"host": "example.com", "port": 25, "user": "mail_user", "pass": "qwerty",
+				}},
+			},
+			expected: `{"name":"smtp","enabled":true,"default_from_address":"accounts@example.com",` +
+				`"credentials":{"smtp_host":"example.com","smtp_port":25,"smtp_user":"mail_user","smtp_pass":"qwerty"}}`,
+		},
+		{
+			name: "ms365",
+			raw: map[string]interface{}{
+				"default_from_address": "accounts@example.com",
+				"ms365": []interface{}{map[string]interface{}{
+					"tenant_id": "tenant-id", "client_id": "client-id", "client_secret": "client-secret",
+				}},
+			},
+			expected: `{"name":"ms365","default_from_address":"accounts@example.com",` +
+				`"credentials":{"tenantId":"tenant-id","clientId":"client-id","clientSecret":"client-secret"}}`,
+		},
+		{
+			name: "azure_cs",
+			raw: map[string]interface{}{
+				"default_from_address": "accounts@example.com",
+				"azure_cs": []interface{}{map[string]interface{}{
+					"connection_string": "endpoint=https://example.communication.azure.com/;accesskey=xxxxx",
+				}},
+			},
+			expected: `{"name":"azure_cs","default_from_address":"accounts@example.com",` +
+				`"credentials":{"connectionString":"endpoint=https://example.communication.azure.com/;accesskey=xxxxx"}}`,
+		},
+		{
+			name: "custom",
+			raw: map[string]interface{}{
+				"default_from_address": "accounts@example.com",
+				"custom":               []interface{}{map[string]interface{}{}},
+			},
+			expected: `{"name":"custom","default_from_address":"accounts@example.com"}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, newEmail().Schema, tc.raw)
+			b, err := json.Marshal(buildEmail(d))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, b)
+			}
+		})
+	}
+}
+
+func TestFlattenEmailProvider(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		state    map[string]interface{}
+		email    *email
+		expected interface{}
+	}{
+		{
+			name: "ses keeps write only credentials",
+			state: map[string]interface{}{
+				"ses.0.access_key_id":     "access-key",
+				"ses.0.secret_access_key": "secret",
+			},
+			email: &email{
+				Email:       management.Email{Name: auth0.String("ses")},
+				Credentials: &emailCredentials{EmailCredentials: management.EmailCredentials{Region: auth0.String("eu-west-2")}},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"access_key_id":     "access-key",
+				"secret_access_key": "secret",
+				"region":            auth0.String("eu-west-2"),
+			}},
+		},
+		{
+			name: "ms365 prefers the api values",
+			state: map[string]interface{}{
+				"ms365.0.tenant_id":     "old-tenant-id",
+				"ms365.0.client_id":     "client-id",
+				"ms365.0.client_secret": "client-secret",
+			},
+			email: &email{
+				Email:       management.Email{Name: auth0.String("ms365")},
+				Credentials: &emailCredentials{TenantID: auth0.String("tenant-id")},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"tenant_id":     auth0.String("tenant-id"),
+				"client_id":     "client-id",
+				"client_secret": "client-secret",
+			}},
+		},
+		{
+			name: "azure_cs without credentials",
+			state: map[string]interface{}{
+				"azure_cs.0.connection_string": "connection-string",
+			},
+			email: &email{
+				Email: management.Email{Name: auth0.String("azure_cs")},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"connection_string": "connection-string",
+			}},
+		},
+		{
+			name:  "custom",
+			state: map[string]interface{}{},
+			email: &email{
+				Email: management.Email{Name: auth0.String("custom")},
+			},
+			expected: []interface{}{map[string]interface{}{}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			key, value := flattenEmailProvider(MapData(tc.state), tc.email)
+			if key != auth0.StringValue(tc.email.Name) {
+				t.Errorf("expected key %s, got %s", auth0.StringValue(tc.email.Name), key)
+			}
+			if !reflect.DeepEqual(value, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+var promptCustomTextPrompts = []string{
+	"login", "login-id", "login-password", "login-passwordless", "login-email-verification",
+	"signup", "signup-id", "signup-password",
+	"reset-password", "consent", "status", "device-flow",
+	"email-verification", "email-otp-challenge", "organizations", "invitation", "common",
+	"mfa", "mfa-push", "mfa-otp", "mfa-voice", "mfa-phone", "mfa-webauthn", "mfa-sms", "mfa-email",
+	"mfa-recovery-code",
+}
+
+func newPromptCustomText() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPromptCustomText,
+		ReadContext:   readPromptCustomText,
+		UpdateContext: updatePromptCustomText,
+		DeleteContext: deletePromptCustomText,
+		CustomizeDiff: validatePromptCustomTextLanguage,
+		Importer: &schema.ResourceImporter{
+			StateContext: importPromptCustomText,
+		},
+		Description: `With this resource, you can manage custom text on your Auth0 prompts.
+You can read more about custom texts [here](https://auth0.com/docs/customize/universal-login-pages/customize-login-text-prompts).
+
+The resource can be imported using the ` + "`prompt::language`" + ` format, e.g. ` + "`login::en`",
+		Schema: map[string]*schema.Schema{
+			"prompt": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The term `prompt` is used to refer to a specific step in the login flow. Options include `" + strings.Join(promptCustomTextPrompts, "`, `") + "`",
+				ValidateFunc: validation.StringInSlice(promptCustomTextPrompts, false),
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Language of the custom text. It must be one of the languages enabled " +
+					"in the tenant `enabled_locales`",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "JSON containing the custom texts. You can check the options for each prompt [here](https://auth0.com/docs/customize/universal-login-pages/customize-login-text-prompts#prompt-values)",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func createPromptCustomText(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("prompt").(string) + "::" + d.Get("language").(string))
+	return updatePromptCustomText(ctx, d, m)
+}
+
+func readPromptCustomText(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	prompt, language, err := parsePromptCustomTextID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	api := m.(*management.Management)
+	var body map[string]interface{}
+	err = api.Request("GET", promptCustomTextURI(api, prompt, language), &body, management.Context(ctx))
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+
+	b, err := structure.FlattenJsonToString(body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("prompt", prompt)
+	_ = d.Set("language", language)
+	_ = d.Set("body", b)
+	return nil
+}
+
+func updatePromptCustomText(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body, err := JSON(d, "body")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+
+	api := m.(*management.Management)
+	err = api.Request("PUT", promptCustomTextURI(api, d.Get("prompt").(string), d.Get("language").(string)),
+		&body, management.Context(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return readPromptCustomText(ctx, d, m)
+}
+
+func deletePromptCustomText(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+
+	// Custom texts can't be deleted, setting them to an empty object resets
+	// the prompt to the default texts.
+	body := map[string]interface{}{}
+	err := api.Request("PUT", promptCustomTextURI(api, d.Get("prompt").(string), d.Get("language").(string)),
+		&body, management.Context(ctx))
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	return nil
+}
+
+func importPromptCustomText(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	prompt, language, err := parsePromptCustomTextID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("prompt", prompt)
+	_ = d.Set("language", language)
+	return []*schema.ResourceData{d}, nil
+}
+
+// validatePromptCustomTextLanguage ensures that the language of the custom text
+// is one of the languages enabled on the tenant, since Auth0 only reports
+// this problem once the texts are being set.
+func validatePromptCustomTextLanguage(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("language") || m == nil {
+		return nil
+	}
+	language := d.Get("language").(string)
+
+	api := m.(*management.Management)
+	t, err := api.Tenant.Read(management.Context(ctx))
+	if err != nil {
+		return err
+	}
+	if len(t.EnabledLocales) == 0 {
+		return nil
+	}
+
+	var enabled []string
+	for _, l := range t.EnabledLocales {
+		if s, ok := l.(string); ok {
+			if s == language {
+				return nil
+			}
+			enabled = append(enabled, s)
+		}
+	}
+	return fmt.Errorf("language %q is not enabled on the tenant, enabled languages are: %s",
+		language, strings.Join(enabled, ", "))
+}
+
+func parsePromptCustomTextID(id string) (prompt string, language string, err error) {
+	parts := strings.Split(id, "::")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected prompt::language", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func promptCustomTextURI(api *management.Management, prompt, language string) string {
+	return api.URI("prompts", prompt, "custom-text", language)
+}
@@ -0,0 +1,88 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPromptCustomText(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "auth0_prompt_custom_text" "login" {
+  prompt   = "login"
+  language = "en"
+  body = jsonencode({
+    login = {
+      title       = "Welcome to {companyName}"
+      description = "Login to {companyName}"
+    }
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login", "id", "login::en"),
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login", "prompt", "login"),
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login", "language", "en"),
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login", "body",
+						`{"login":{"description":"Login to {companyName}","title":"Welcome to {companyName}"}}`),
+				),
+			},
+			{
+				Config: `
+resource "auth0_prompt_custom_text" "login" {
+  prompt   = "login"
+  language = "en"
+  body = jsonencode({
+    login = {
+      title = "Welcome back"
+    }
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login", "body", `{"login":{"title":"Welcome back"}}`),
+				),
+			},
+			{
+				ResourceName:      "auth0_prompt_custom_text.login",
+				ImportState:       true,
+				ImportStateId:     "login::en",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParsePromptCustomTextID(t *testing.T) {
+	for _, tc := range []struct {
+		id       string
+		prompt   string
+		language string
+		err      bool
+	}{
+		{id: "login::en", prompt: "login", language: "en"},
+		{id: "mfa-otp::pt-BR", prompt: "mfa-otp", language: "pt-BR"},
+		{id: "login", err: true},
+		{id: "login::", err: true},
+		{id: "::en", err: true},
+		{id: "login::en::fr", err: true},
+	} {
+		prompt, language, err := parsePromptCustomTextID(tc.id)
+		if tc.err {
+			if err == nil {
+				t.Errorf("expected an error parsing %q", tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %v", tc.id, err)
+		}
+		if prompt != tc.prompt || language != tc.language {
+			t.Errorf("parsing %q: expected %s/%s, got %s/%s", tc.id, tc.prompt, tc.language, prompt, language)
+		}
+	}
+}
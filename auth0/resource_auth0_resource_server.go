@@ -2,7 +2,10 @@ package auth0
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
+	"net/url"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
 
@@ -15,6 +18,37 @@ import (
 	"gopkg.in/auth0.v5/management"
 )
 
+// resourceServer extends management.ResourceServer with the fields the SDK
+// lacks.
+type resourceServer struct {
+	management.ResourceServer
+	TokenEncryption      *resourceServerTokenEncryption       `json:"token_encryption,omitempty"`
+	ConsentPolicy        *string                              `json:"consent_policy,omitempty"`
+	AuthorizationDetails []*resourceServerAuthorizationDetail `json:"authorization_details,omitempty"`
+	ProofOfPossession    *resourceServerProofOfPossession     `json:"proof_of_possession,omitempty"`
+}
+
+type resourceServerTokenEncryption struct {
+	Format        *string                      `json:"format,omitempty"`
+	EncryptionKey *resourceServerEncryptionKey `json:"encryption_key,omitempty"`
+}
+
+type resourceServerEncryptionKey struct {
+	Name      *string `json:"name,omitempty"`
+	Algorithm *string `json:"alg,omitempty"`
+	KeyID     *string `json:"kid,omitempty"`
+	PEM       *string `json:"pem,omitempty"`
+}
+
+type resourceServerAuthorizationDetail struct {
+	Type *string `json:"type,omitempty"`
+}
+
+type resourceServerProofOfPossession struct {
+	Mechanism *string `json:"mechanism,omitempty"`
+	Required  *bool   `json:"required,omitempty"`
+}
+
 func newResourceServer() *schema.Resource {
 	return &schema.Resource{
 
@@ -22,6 +56,7 @@ func newResourceServer() *schema.Resource {
 		ReadContext:   readResourceServer,
 		UpdateContext: updateResourceServer,
 		DeleteContext: deleteResourceServer,
+		CustomizeDiff: customizeResourceServerSecretRotation,
 		Description:   "With this resource, you can set up APIs that can be consumed from your authorized applications",
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -60,6 +95,13 @@ func newResourceServer() *schema.Resource {
 					},
 				},
 			},
+			"ignore_external_scopes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Whether the scopes which aren't declared in `scopes`, such as the ones managed with " +
+					"the `auth0_resource_server_scope` resource, are left untouched instead of being removed",
+			},
 			"signing_alg": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -67,22 +109,17 @@ func newResourceServer() *schema.Resource {
 				Description: "Algorithm used to sign JWTs. Options include `HS256` and `RS256`",
 			},
 			"signing_secret": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Secret used to sign tokens when using symmetric algorithms (HS256)",
-				ValidateFunc: func(i interface{}, k string) (s []string, es []error) {
-					v, ok := i.(string)
-					if !ok {
-						es = append(es, fmt.Errorf("expected type of %s to be string", k))
-						return
-					}
-					min := 16
-					if len(v) < min {
-						es = append(es, fmt.Errorf("expected length of %s to be at least %d, %q is %d", k, min, v, len(v)))
-					}
-					return
-				},
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Secret used to sign tokens when using symmetric algorithms (HS256)",
+				ValidateFunc: validateResourceServerSigningSecret,
+			},
+			"signing_secret_rotation_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: "Changing the content of this map replaces the `signing_secret` with a randomly " +
+					"generated one. The `signing_secret` should then be left unspecified",
 			},
 			"allow_offline_access": {
 				Type:        schema.TypeBool,
@@ -123,6 +160,102 @@ func newResourceServer() *schema.Resource {
 				Optional:    true,
 				Description: "Indicates whether or not authorization polices are enforced",
 			},
+			"token_encryption": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Encrypts the access tokens issued for this resource server (JWE) with a public key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "compact-nested-jwe",
+							Description:  "Format of the encrypted tokens. Options include `compact-nested-jwe`",
+							ValidateFunc: validation.StringInSlice([]string{"compact-nested-jwe"}, false),
+						},
+						"encryption_key": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: "Public key the tokens are encrypted with",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the key",
+									},
+									"algorithm": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: "Algorithm of the key. Options include `RSA-OAEP-256`, " +
+											"`RSA-OAEP-384` and `RSA-OAEP-512`",
+										ValidateFunc: validation.StringInSlice([]string{
+											"RSA-OAEP-256",
+											"RSA-OAEP-384",
+											"RSA-OAEP-512",
+										}, false),
+									},
+									"kid": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: "Key identifier, generated by Auth0 when not set",
+									},
+									"pem": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "PEM-formatted public key (`PUBLIC KEY`) or X.509 certificate (`CERTIFICATE`)",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"consent_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Consent policy of the resource server. Options include " +
+					"`transactional-authorization-with-mfa`",
+				ValidateFunc: validation.StringInSlice([]string{"transactional-authorization-with-mfa"}, false),
+			},
+			"authorization_details": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Types of the rich authorization requests (`authorization_details`) the resource server accepts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Type of the authorization details, e.g. `payment_initiation`",
+						},
+					},
+				},
+			},
+			"proof_of_possession": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Sender constraining of the access tokens issued for this resource server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mechanism": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Mechanism binding the tokens to the client. Options include `mtls` and `dpop`",
+							ValidateFunc: validation.StringInSlice([]string{"mtls", "dpop"}, false),
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the tokens must be bound to the client",
+						},
+					},
+				},
+			},
 			"token_dialect": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -140,7 +273,7 @@ func newResourceServer() *schema.Resource {
 func createResourceServer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s := expandResourceServer(d)
 	api := m.(*management.Management)
-	if err := api.ResourceServer.Create(s, management.Context(ctx)); err != nil {
+	if err := api.Request("POST", api.URI("resource-servers"), s, management.Context(ctx)); err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(auth0.StringValue(s.ID))
@@ -149,16 +282,21 @@ func createResourceServer(ctx context.Context, d *schema.ResourceData, m interfa
 
 func readResourceServer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*management.Management)
-	s, err := api.ResourceServer.Read(d.Id(), management.Context(ctx))
-	if err != nil {
+	var s *resourceServer
+	if err := api.Request("GET", resourceServerURI(api, d.Id()), &s, management.Context(ctx)); err != nil {
 		return flow.DefaultManagementError(err, d)
 	}
 
 	d.SetId(auth0.StringValue(s.ID))
 	_ = d.Set("name", s.Name)
 	_ = d.Set("identifier", s.Identifier)
+	// The data source doesn't have ignore_external_scopes.
+	ignoreExternalScopes, _ := d.Get("ignore_external_scopes").(bool)
 	_ = d.Set("scopes", func() (m []map[string]interface{}) {
 		for _, scope := range s.Scopes {
+			if ignoreExternalScopes && !hasResourceServerScope(d, scope.GetValue()) {
+				continue
+			}
 			m = append(m, map[string]interface{}{
 				"value":       scope.Value,
 				"description": scope.Description,
@@ -176,6 +314,10 @@ func readResourceServer(ctx context.Context, d *schema.ResourceData, m interface
 	_ = d.Set("options", s.Options)
 	_ = d.Set("enforce_policies", s.EnforcePolicies)
 	_ = d.Set("token_dialect", s.TokenDialect)
+	_ = d.Set("token_encryption", flattenResourceServerTokenEncryption(d, s.TokenEncryption))
+	_ = d.Set("consent_policy", s.ConsentPolicy)
+	_ = d.Set("authorization_details", flattenResourceServerAuthorizationDetails(s.AuthorizationDetails))
+	_ = d.Set("proof_of_possession", flattenResourceServerProofOfPossession(s.ProofOfPossession))
 	return nil
 }
 
@@ -183,10 +325,46 @@ func updateResourceServer(ctx context.Context, d *schema.ResourceData, m interfa
 	s := expandResourceServer(d)
 	s.Identifier = nil
 	api := m.(*management.Management)
-	err := api.ResourceServer.Update(d.Id(), s, management.Context(ctx))
+
+	if d.HasChange("signing_secret_rotation_trigger") {
+		secret, err := generateResourceServerSigningSecret()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		s.SigningSecret = &secret
+	}
+
+	if d.Get("ignore_external_scopes").(bool) {
+		// Only the declared scopes are changed, the other ones are kept.
+		identifier := d.Get("identifier").(string)
+		globalMutex.Lock("resource_server:" + identifier)
+		defer globalMutex.Unlock("resource_server:" + identifier)
+
+		s.Scopes = nil
+		if d.HasChange("scopes") {
+			current, err := readResourceServerScopes(ctx, api, d.Id())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			add, rm := Diff(d, "scopes")
+			scopes := mergeResourceServerScopes(current, expandResourceServerScopes(add), expandResourceServerScopes(rm))
+			if err := updateResourceServerScopes(ctx, api, d.Id(), scopes); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	err := api.Request("PATCH", resourceServerURI(api, d.Id()), s, management.Context(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// Settings which were removed have to be reset explicitly.
+	if nulls := resourceServerRemovedSettings(d); len(nulls) > 0 {
+		err := api.Request("PATCH", resourceServerURI(api, d.Id()), &nulls, management.Context(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return readResourceServer(ctx, d, m)
 }
 
@@ -199,8 +377,8 @@ func deleteResourceServer(ctx context.Context, d *schema.ResourceData, m interfa
 	return diag.FromErr(err)
 }
 
-func expandResourceServer(d *schema.ResourceData) *management.ResourceServer {
-	s := &management.ResourceServer{
+func expandResourceServer(d *schema.ResourceData) *resourceServer {
+	s := &resourceServer{ResourceServer: management.ResourceServer{
 		Name:                 String(d, "name"),
 		Identifier:           String(d, "identifier"),
 		SigningAlgorithm:     String(d, "signing_alg"),
@@ -214,7 +392,7 @@ func expandResourceServer(d *schema.ResourceData) *management.ResourceServer {
 		TokenDialect:         String(d, "token_dialect", IsNewResource(), HasChange()),
 
 		SkipConsentForVerifiableFirstPartyClients: Bool(d, "skip_consent_for_verifiable_first_party_clients"),
-	}
+	}}
 
 	Set(d, "scopes").Elem(func(d ResourceData) {
 		s.Scopes = append(s.Scopes, &management.ResourceServerScope{
@@ -223,5 +401,194 @@ func expandResourceServer(d *schema.ResourceData) *management.ResourceServer {
 		})
 	})
 
+	s.ConsentPolicy = String(d, "consent_policy")
+
+	List(d, "token_encryption").Elem(func(d ResourceData) {
+		s.TokenEncryption = &resourceServerTokenEncryption{
+			Format: String(d, "format"),
+		}
+		List(d, "encryption_key").Elem(func(d ResourceData) {
+			s.TokenEncryption.EncryptionKey = &resourceServerEncryptionKey{
+				Name:      String(d, "name"),
+				Algorithm: String(d, "algorithm"),
+				KeyID:     String(d, "kid"),
+				PEM:       String(d, "pem"),
+			}
+		})
+	})
+
+	List(d, "authorization_details").Elem(func(d ResourceData) {
+		s.AuthorizationDetails = append(s.AuthorizationDetails, &resourceServerAuthorizationDetail{
+			Type: String(d, "type"),
+		})
+	})
+
+	List(d, "proof_of_possession").Elem(func(d ResourceData) {
+		s.ProofOfPossession = &resourceServerProofOfPossession{
+			Mechanism: String(d, "mechanism"),
+			Required:  Bool(d, "required"),
+		}
+	})
+
 	return s
 }
+
+// resourceServerRemovedSettings returns the settings which were removed from
+// the configuration, which the API only resets when they are null.
+func resourceServerRemovedSettings(d ResourceData) map[string]interface{} {
+	nulls := map[string]interface{}{}
+	for _, k := range []string{"token_encryption", "consent_policy", "authorization_details", "proof_of_possession"} {
+		if _, ok := d.GetOk(k); !ok && d.HasChange(k) {
+			nulls[k] = nil
+		}
+	}
+	return nulls
+}
+
+func flattenResourceServerTokenEncryption(d ResourceData, e *resourceServerTokenEncryption) []interface{} {
+	if e == nil {
+		return nil
+	}
+	m := map[string]interface{}{
+		"format": e.Format,
+	}
+	if k := e.EncryptionKey; k != nil {
+		// Auth0 doesn't return the key itself, keep the configured one.
+		pem := auth0.StringValue(k.PEM)
+		if pem == "" {
+			pem, _ = d.Get("token_encryption.0.encryption_key.0.pem").(string)
+		}
+		m["encryption_key"] = []interface{}{
+			map[string]interface{}{
+				"name":      k.Name,
+				"algorithm": k.Algorithm,
+				"kid":       k.KeyID,
+				"pem":       pem,
+			},
+		}
+	}
+	return []interface{}{m}
+}
+
+func flattenResourceServerAuthorizationDetails(details []*resourceServerAuthorizationDetail) []interface{} {
+	var l []interface{}
+	for _, detail := range details {
+		l = append(l, map[string]interface{}{
+			"type": detail.Type,
+		})
+	}
+	return l
+}
+
+func flattenResourceServerProofOfPossession(p *resourceServerProofOfPossession) []interface{} {
+	if p == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"mechanism": p.Mechanism,
+			"required":  p.Required,
+		},
+	}
+}
+
+func validateResourceServerSigningSecret(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	min := 16
+	if len(v) < min {
+		es = append(es, fmt.Errorf("expected length of %s to be at least %d, %q is %d", k, min, v, len(v)))
+	}
+	return
+}
+
+// generateResourceServerSigningSecret returns a random secret, long enough
+// for HS256.
+func generateResourceServerSigningSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	if _, errs := validateResourceServerSigningSecret(secret, "signing_secret"); len(errs) > 0 {
+		return "", errs[0]
+	}
+	return secret, nil
+}
+
+// customizeResourceServerSecretRotation plans the rotation of the signing
+// secret when the rotation trigger changes.
+func customizeResourceServerSecretRotation(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("signing_secret_rotation_trigger") {
+		return nil
+	}
+	return d.SetNewComputed("signing_secret")
+}
+
+func expandResourceServerScopes(l []interface{}) (scopes []*management.ResourceServerScope) {
+	for _, v := range l {
+		scope := v.(map[string]interface{})
+		scopes = append(scopes, &management.ResourceServerScope{
+			Value:       auth0.String(scope["value"].(string)),
+			Description: auth0.String(scope["description"].(string)),
+		})
+	}
+	return
+}
+
+// resourceServerURI returns the URI of the resource server, which can be
+// identified by its ID or by its identifier.
+func resourceServerURI(api *management.Management, id string) string {
+	return api.URI("resource-servers") + "/" + url.PathEscape(id)
+}
+
+func readResourceServerScopes(ctx context.Context, api *management.Management, id string) ([]*management.ResourceServerScope, error) {
+	var s *management.ResourceServer
+	err := api.Request("GET", resourceServerURI(api, id), &s, management.Context(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return s.Scopes, nil
+}
+
+// updateResourceServerScopes replaces the scopes of the resource server,
+// including with an empty list, which the SDK omits.
+func updateResourceServerScopes(ctx context.Context, api *management.Management, id string, scopes []*management.ResourceServerScope) error {
+	if scopes == nil {
+		scopes = []*management.ResourceServerScope{}
+	}
+	body := struct {
+		Scopes []*management.ResourceServerScope `json:"scopes"`
+	}{scopes}
+	return api.Request("PATCH", resourceServerURI(api, id), &body, management.Context(ctx))
+}
+
+// mergeResourceServerScopes removes the scopes of rm from the current scopes,
+// by value, then adds or replaces the scopes of add.
+func mergeResourceServerScopes(current, add, rm []*management.ResourceServerScope) []*management.ResourceServerScope {
+	drop := make(map[string]bool)
+	for _, scope := range append(rm, add...) {
+		drop[scope.GetValue()] = true
+	}
+	scopes := []*management.ResourceServerScope{}
+	for _, scope := range current {
+		if !drop[scope.GetValue()] {
+			scopes = append(scopes, scope)
+		}
+	}
+	return append(scopes, add...)
+}
+
+// hasResourceServerScope reports whether the scope is declared by the
+// resource server.
+func hasResourceServerScope(d ResourceData, value string) bool {
+	for _, v := range Set(d, "scopes").List() {
+		if v.(map[string]interface{})["value"] == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func newResourceServerScope() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createResourceServerScope,
+		ReadContext:   readResourceServerScope,
+		UpdateContext: updateResourceServerScope,
+		DeleteContext: deleteResourceServerScope,
+		Importer: &schema.ResourceImporter{
+			StateContext: importResourceServerScope,
+		},
+		Description: `With this resource, you can manage a single permission (scope) of a resource server, so that the
+scopes of a resource server can be declared by different modules. The resource server should set
+` + "`ignore_external_scopes`" + `, so that it doesn't remove the scopes managed with this resource.
+
+The resource can be imported using the ` + "`resource_server_identifier::scope`" + ` format.`,
+		Schema: map[string]*schema.Schema{
+			"resource_server_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the resource server the scope belongs to",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the permission (scope). Examples include `read:appointments` or `delete:appointments`",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the permission (scope)",
+			},
+		},
+	}
+}
+
+func createResourceServerScope(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	identifier := d.Get("resource_server_identifier").(string)
+	value := d.Get("scope").(string)
+
+	err := modifyResourceServerScopes(ctx, m.(*management.Management), identifier, func(scopes []*management.ResourceServerScope) ([]*management.ResourceServerScope, error) {
+		for _, scope := range scopes {
+			if scope.GetValue() == value {
+				return nil, fmt.Errorf("the scope %q already exists on the resource server %q", value, identifier)
+			}
+		}
+		return append(scopes, expandResourceServerScope(d)), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(identifier + "::" + value)
+
+	return readResourceServerScope(ctx, d, m)
+}
+
+func readResourceServerScope(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	identifier, value, err := parseResourceServerScopeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	scopes, err := readResourceServerScopes(ctx, m.(*management.Management), identifier)
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+
+	for _, scope := range scopes {
+		if scope.GetValue() == value {
+			_ = d.Set("resource_server_identifier", identifier)
+			_ = d.Set("scope", value)
+			_ = d.Set("description", scope.Description)
+			return nil
+		}
+	}
+
+	// The scope was removed outside of Terraform.
+	d.SetId("")
+	return nil
+}
+
+func updateResourceServerScope(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	identifier, value, err := parseResourceServerScopeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = modifyResourceServerScopes(ctx, m.(*management.Management), identifier, func(scopes []*management.ResourceServerScope) ([]*management.ResourceServerScope, error) {
+		for i, scope := range scopes {
+			if scope.GetValue() == value {
+				scopes[i] = expandResourceServerScope(d)
+				return scopes, nil
+			}
+		}
+		return nil, fmt.Errorf("the scope %q doesn't exist on the resource server %q", value, identifier)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readResourceServerScope(ctx, d, m)
+}
+
+func deleteResourceServerScope(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	identifier, value, err := parseResourceServerScopeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = modifyResourceServerScopes(ctx, m.(*management.Management), identifier, func(scopes []*management.ResourceServerScope) ([]*management.ResourceServerScope, error) {
+		return mergeResourceServerScopes(scopes, nil, []*management.ResourceServerScope{
+			{Value: auth0.String(value)},
+		}), nil
+	})
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	return nil
+}
+
+func importResourceServerScope(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseResourceServerScopeID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandResourceServerScope(d ResourceData) *management.ResourceServerScope {
+	return &management.ResourceServerScope{
+		Value:       String(d, "scope"),
+		Description: String(d, "description"),
+	}
+}
+
+// modifyResourceServerScopes reads the scopes of the resource server, modifies
+// them with fn and writes them back. The scopes of a resource server are
+// modified one at a time, so that concurrent changes aren't lost.
+func modifyResourceServerScopes(ctx context.Context, api *management.Management, identifier string, fn func([]*management.ResourceServerScope) ([]*management.ResourceServerScope, error)) error {
+	globalMutex.Lock("resource_server:" + identifier)
+	defer globalMutex.Unlock("resource_server:" + identifier)
+
+	scopes, err := readResourceServerScopes(ctx, api, identifier)
+	if err != nil {
+		return err
+	}
+	scopes, err = fn(scopes)
+	if err != nil {
+		return err
+	}
+	return updateResourceServerScopes(ctx, api, identifier, scopes)
+}
+
+func parseResourceServerScopeID(id string) (identifier, value string, err error) {
+	i := strings.LastIndex(id, "::")
+	if i <= 0 || i+2 >= len(id) {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected resource_server_identifier::scope", id)
+	}
+	return id[:i], id[i+2:], nil
+}
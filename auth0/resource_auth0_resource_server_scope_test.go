@@ -0,0 +1,109 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+const testAccResourceServerScopeConfig = `
+resource "auth0_resource_server" "my_resource_server" {
+	name = "Acceptance Test - Scopes - {{.random}}"
+	identifier = "https://uat.api.alexkappa.com/scopes/{{.random}}"
+	ignore_external_scopes = true
+	scopes {
+		value = "create:foo"
+		description = "Create foos"
+	}
+}
+
+resource "auth0_resource_server_scope" "read_foo" {
+	resource_server_identifier = auth0_resource_server.my_resource_server.identifier
+	scope = "read:foo"
+	description = "{{.description}}"
+}
+
+resource "auth0_resource_server_scope" "delete_foo" {
+	resource_server_identifier = auth0_resource_server.my_resource_server.identifier
+	scope = "delete:foo"
+}
+`
+
+func TestAccResourceServerScope(t *testing.T) {
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.TemplateMap(testAccResourceServerScopeConfig, map[string]string{"random": rand, "description": "Read foos"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_resource_server.my_resource_server", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read_foo", "scope", "read:foo"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read_foo", "description", "Read foos"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.delete_foo", "scope", "delete:foo"),
+				),
+			},
+			{
+				Config: random.TemplateMap(testAccResourceServerScopeConfig, map[string]string{"random": rand, "description": "Read all the foos"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_resource_server.my_resource_server", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read_foo", "description", "Read all the foos"),
+				),
+			},
+			{
+				ResourceName:      "auth0_resource_server_scope.read_foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseResourceServerScopeID(t *testing.T) {
+	identifier, value, err := parseResourceServerScopeID("https://api.example.com/::read:messages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identifier != "https://api.example.com/" || value != "read:messages" {
+		t.Errorf("unexpected resource_server_identifier %q and scope %q", identifier, value)
+	}
+	for _, id := range []string{"https://api.example.com/", "::read:messages", "https://api.example.com/::"} {
+		if _, _, err := parseResourceServerScopeID(id); err == nil {
+			t.Errorf("expected an error for ID %q", id)
+		}
+	}
+}
+
+func TestMergeResourceServerScopes(t *testing.T) {
+	scope := func(value, description string) *management.ResourceServerScope {
+		return &management.ResourceServerScope{Value: auth0.String(value), Description: auth0.String(description)}
+	}
+	current := []*management.ResourceServerScope{
+		scope("read:foo", "Read foos"),
+		scope("create:foo", "Create foos"),
+		scope("delete:foo", "Delete foos"),
+	}
+
+	scopes := mergeResourceServerScopes(current,
+		[]*management.ResourceServerScope{scope("create:foo", "Create all the foos"), scope("update:foo", "Update foos")},
+		[]*management.ResourceServerScope{scope("create:foo", "Create foos"), scope("delete:foo", "Delete foos")})
+
+	expected := []string{"read:foo=Read foos", "create:foo=Create all the foos", "update:foo=Update foos"}
+	if len(scopes) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, scopes)
+	}
+	for i, s := range scopes {
+		if s.GetValue()+"="+s.GetDescription() != expected[i] {
+			t.Errorf("expected %s, got %s=%s", expected[i], s.GetValue(), s.GetDescription())
+		}
+	}
+
+	if scopes := mergeResourceServerScopes(current[:1], nil, current[:1]); scopes == nil || len(scopes) != 0 {
+		t.Errorf("expected an empty, non nil, list of scopes, got %v", scopes)
+	}
+}
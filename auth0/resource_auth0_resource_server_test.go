@@ -1,15 +1,19 @@
 package auth0
 
 import (
+	"fmt"
 	"log"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
+	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/management"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func init() {
@@ -108,3 +112,102 @@ resource "auth0_resource_server" "my_resource_server" {
 		},
 	})
 }
+
+const testAccResourceServerSecretRotationConfig = `
+resource "auth0_resource_server" "my_resource_server" {
+	name = "Acceptance Test - Rotation - {{.random}}"
+	identifier = "https://uat.api.alexkappa.com/rotation/{{.random}}"
+	signing_alg = "HS256"
+	signing_secret_rotation_trigger = {
+		date = "{{.date}}"
+	}
+	token_encryption {
+		encryption_key {
+			name = "encryption-key"
+			algorithm = "RSA-OAEP-256"
+			pem = <<EOF
+{{.pem}}
+EOF
+		}
+	}
+}
+`
+
+func TestAccResourceServerSecretRotation(t *testing.T) {
+	rand := random.String(6)
+
+	var secret string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.TemplateMap(testAccResourceServerSecretRotationConfig, map[string]string{"random": rand, "date": "2021-01-01", "pem": testAccClientCredentialKey1}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_resource_server.my_resource_server", "token_encryption.0.format", "compact-nested-jwe"),
+					resource.TestCheckResourceAttr("auth0_resource_server.my_resource_server", "token_encryption.0.encryption_key.0.algorithm", "RSA-OAEP-256"),
+					resource.TestCheckResourceAttrSet("auth0_resource_server.my_resource_server", "token_encryption.0.encryption_key.0.kid"),
+					func(s *terraform.State) error {
+						secret = s.RootModule().Resources["auth0_resource_server.my_resource_server"].Primary.Attributes["signing_secret"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: random.TemplateMap(testAccResourceServerSecretRotationConfig, map[string]string{"random": rand, "date": "2021-02-01", "pem": testAccClientCredentialKey1}),
+				Check: func(s *terraform.State) error {
+					rotated := s.RootModule().Resources["auth0_resource_server.my_resource_server"].Primary.Attributes["signing_secret"]
+					if rotated == "" || rotated == secret {
+						return fmt.Errorf("expected the signing secret to be rotated")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestGenerateResourceServerSigningSecret(t *testing.T) {
+	a, err := generateResourceServerSigningSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := generateResourceServerSigningSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a) < 16 || a == b {
+		t.Errorf("expected distinct secrets of at least 16 characters, got %q and %q", a, b)
+	}
+}
+
+func TestFlattenResourceServerTokenEncryption(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, newResourceServer().Schema, map[string]interface{}{
+		"token_encryption": []interface{}{
+			map[string]interface{}{
+				"encryption_key": []interface{}{
+					map[string]interface{}{
+						"name":      "encryption-key",
+						"algorithm": "RSA-OAEP-256",
+						"pem":       testAccClientCredentialKey1,
+					},
+				},
+			},
+		},
+	})
+
+	s := expandResourceServer(d)
+	if auth0.StringValue(s.TokenEncryption.Format) != "compact-nested-jwe" ||
+		auth0.StringValue(s.TokenEncryption.EncryptionKey.Algorithm) != "RSA-OAEP-256" {
+		t.Fatalf("unexpected token encryption %v", s.TokenEncryption)
+	}
+
+	// Auth0 doesn't return the key.
+	s.TokenEncryption.EncryptionKey.PEM = nil
+	s.TokenEncryption.EncryptionKey.KeyID = auth0.String("kid_123")
+	l := flattenResourceServerTokenEncryption(d, s.TokenEncryption)
+	key := l[0].(map[string]interface{})["encryption_key"].([]interface{})[0].(map[string]interface{})
+	if key["pem"] != testAccClientCredentialKey1 || auth0.StringValue(key["kid"].(*string)) != "kid_123" {
+		t.Errorf("unexpected encryption key %v", key)
+	}
+}
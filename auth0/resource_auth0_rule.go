@@ -54,7 +54,8 @@ You can create global variable for use with rules by using the auth0_rule_config
 				Optional: true,
 				Computed: true,
 				Description: "Order in which the rule executes relative to other rules. " +
-					"Lower-valued rules execute first",
+					"Lower-valued rules execute first. Leave it out when the rule is ordered with the " +
+					"`auth0_rule_order` resource",
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
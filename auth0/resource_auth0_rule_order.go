@@ -0,0 +1,171 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func newRuleOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createRuleOrder,
+		ReadContext:   readRuleOrder,
+		UpdateContext: updateRuleOrder,
+		DeleteContext: deleteRuleOrder,
+		Description: `With this resource, you can set the order in which rules execute. The rules are given the
+orders 1, 2, 3, and so on, following the order of ` + "`rule_ids`" + `, so the ` + "`order`" + ` of the
+` + "`auth0_rule`" + ` resources can be left out. Rules are moved to temporary orders first, so that no two rules
+ever share an order.
+
+The rules which aren't listed mustn't use the orders given to the listed rules. Destroying the resource leaves the
+orders untouched.`,
+		Schema: map[string]*schema.Schema{
+			"rule_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				Description: "IDs of the rules, in the order in which they execute",
+			},
+		},
+	}
+}
+
+func createRuleOrder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := reorderRules(ctx, m.(*management.Management), d.Get("rule_ids").([]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resource.UniqueId())
+	return readRuleOrder(ctx, d, m)
+}
+
+func readRuleOrder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	rules, err := listRules(ctx, m.(*management.Management))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	orders := make(map[string]int, len(rules))
+	for _, rule := range rules {
+		orders[rule.GetID()] = rule.GetOrder()
+	}
+
+	// The rules which were deleted are dropped, the others are listed in the
+	// order in which they execute.
+	var ids []string
+	for _, id := range d.Get("rule_ids").([]interface{}) {
+		if _, ok := orders[id.(string)]; ok {
+			ids = append(ids, id.(string))
+		}
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		return orders[ids[i]] < orders[ids[j]]
+	})
+	_ = d.Set("rule_ids", ids)
+	return nil
+}
+
+func updateRuleOrder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := reorderRules(ctx, m.(*management.Management), d.Get("rule_ids").([]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+	return readRuleOrder(ctx, d, m)
+}
+
+func deleteRuleOrder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// reorderRules gives the rules sequential orders, following the order of ids.
+func reorderRules(ctx context.Context, api *management.Management, ids []interface{}) error {
+	rules, err := listRules(ctx, api)
+	if err != nil {
+		return err
+	}
+	updates, err := planRuleOrder(rules, ids)
+	if err != nil {
+		return err
+	}
+	for _, u := range updates {
+		err := api.Rule.Update(u.id, &management.Rule{Order: auth0.Int(u.order)}, management.Context(ctx))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ruleOrderUpdate struct {
+	id    string
+	order int
+}
+
+// planRuleOrder returns the updates giving the rules the orders 1, 2, 3, etc.
+// following the order of ids. The rules which have to move are moved above the
+// orders of every rule first, so that no two rules share an order at any time.
+func planRuleOrder(rules []*management.Rule, ids []interface{}) ([]ruleOrderUpdate, error) {
+	target := make(map[string]int, len(ids))
+	for i, id := range ids {
+		if _, ok := target[id.(string)]; ok {
+			return nil, fmt.Errorf("the rule %q is listed more than once", id)
+		}
+		target[id.(string)] = i + 1
+	}
+
+	current := make(map[string]int, len(rules))
+	highest := len(ids)
+	for _, rule := range rules {
+		current[rule.GetID()] = rule.GetOrder()
+		if rule.GetOrder() > highest {
+			highest = rule.GetOrder()
+		}
+		if _, ok := target[rule.GetID()]; !ok && rule.GetOrder() >= 1 && rule.GetOrder() <= len(ids) {
+			return nil, fmt.Errorf("the rule %q (%s) has the order %d, which is given to the listed rules",
+				rule.GetName(), rule.GetID(), rule.GetOrder())
+		}
+	}
+
+	var moves []string
+	for _, id := range ids {
+		order, ok := current[id.(string)]
+		if !ok {
+			return nil, fmt.Errorf("the rule %q doesn't exist", id)
+		}
+		if order != target[id.(string)] {
+			moves = append(moves, id.(string))
+		}
+	}
+
+	updates := make([]ruleOrderUpdate, 0, 2*len(moves))
+	for i, id := range moves {
+		updates = append(updates, ruleOrderUpdate{id, highest + 1 + i})
+	}
+	for _, id := range moves {
+		updates = append(updates, ruleOrderUpdate{id, target[id]})
+	}
+	return updates, nil
+}
+
+func listRules(ctx context.Context, api *management.Management) ([]*management.Rule, error) {
+	var rules []*management.Rule
+	var page int
+	for {
+		l, err := api.Rule.List(management.Page(page), management.Context(ctx))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, l.Rules...)
+		if !l.HasNext() {
+			return rules, nil
+		}
+		page++
+	}
+}
@@ -0,0 +1,98 @@
+package auth0
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func TestAccRuleOrder(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccRuleOrderRules+`
+resource "auth0_rule_order" "my_order" {
+	rule_ids = [ auth0_rule.first.id, auth0_rule.second.id ]
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_rule_order.my_order", "rule_ids.0", "auth0_rule.first", "id"),
+					resource.TestCheckResourceAttrPair("auth0_rule_order.my_order", "rule_ids.1", "auth0_rule.second", "id"),
+				),
+			},
+			{
+				Config: random.Template(testAccRuleOrderRules+`
+resource "auth0_rule_order" "my_order" {
+	rule_ids = [ auth0_rule.second.id, auth0_rule.first.id ]
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_rule_order.my_order", "rule_ids.0", "auth0_rule.second", "id"),
+					resource.TestCheckResourceAttrPair("auth0_rule_order.my_order", "rule_ids.1", "auth0_rule.first", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRuleOrderRules = `
+resource "auth0_rule" "first" {
+	name = "acceptance-test-first-{{.random}}"
+	script = "function (user, context, callback) { callback(null, user, context); }"
+	enabled = true
+}
+
+resource "auth0_rule" "second" {
+	name = "acceptance-test-second-{{.random}}"
+	script = "function (user, context, callback) { callback(null, user, context); }"
+	enabled = true
+}
+`
+
+func TestPlanRuleOrder(t *testing.T) {
+	rules := []*management.Rule{
+		{ID: auth0.String("rul_a"), Order: auth0.Int(1)},
+		{ID: auth0.String("rul_b"), Order: auth0.Int(2)},
+		{ID: auth0.String("rul_c"), Order: auth0.Int(5)},
+		{ID: auth0.String("rul_d"), Order: auth0.Int(7)},
+	}
+
+	updates, err := planRuleOrder(rules, []interface{}{"rul_b", "rul_a", "rul_c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ruleOrderUpdate{
+		{"rul_b", 8}, {"rul_a", 9}, {"rul_c", 10},
+		{"rul_b", 1}, {"rul_a", 2}, {"rul_c", 3},
+	}
+	if !reflect.DeepEqual(expected, updates) {
+		t.Errorf("expected %v, got %v", expected, updates)
+	}
+
+	updates, err = planRuleOrder(rules, []interface{}{"rul_a", "rul_b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updates) != 0 {
+		t.Errorf("expected no updates, got %v", updates)
+	}
+
+	for _, ids := range [][]interface{}{
+		{"rul_a", "rul_a"},
+		{"rul_a", "rul_x"},
+		{"rul_c"},
+	} {
+		if _, err := planRuleOrder(rules, ids); err == nil {
+			t.Errorf("expected an error for %v", ids)
+		}
+	}
+}
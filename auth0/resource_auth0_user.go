@@ -1,15 +1,17 @@
 package auth0
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -69,9 +71,35 @@ func newUser() *schema.Resource {
 				Computed: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_version"},
+				Description: "Password of the user, only sent when the user is created or `password_version` " +
+					"changes. Unlike `password`, it is never stored in the state",
+				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+					return d.Id() != "" && !d.HasChange("password_version")
+				},
+			},
+			"password_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of `password_wo`. Change it to send the password again",
+			},
+			"password_change_email": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Whether to email the user a link to change their password every time " +
+					"`password_version` changes, instead of sending `password_wo`. The password is still used to " +
+					"create the user",
 			},
 			"email": {
 				Type:     schema.TypeString,
@@ -119,6 +147,132 @@ func newUser() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Description: "Permissions (scopes) assigned directly to the user, outside of its roles. The " +
+					"permissions are left untouched when not set, so that they can be managed with the " +
+					"`auth0_user_permission` resource instead",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the permission (scope)",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the permission",
+						},
+						"resource_server_identifier": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Unique identifier for the resource server",
+						},
+						"resource_server_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource server name",
+						},
+					},
+				},
+			},
+			"blocked_for": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "Blocks of the user due to too many failed logins, see the `auth0_user_unblock` resource. " +
+					"Left unset when the token of the provider lacks the scope to read them",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the blocked user, e.g. its email or username",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address the logins are blocked from",
+						},
+					},
+				},
+			},
+			"multifactor": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "Guardian MFA enrollments of the user, see the `auth0_user_mfa_reset` resource. " +
+					"Left unset when the token of the provider lacks the scope to read them",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the enrollment",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the enrollment, `pending` or `confirmed`",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the enrollment",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the enrollment, usually a phone number",
+						},
+						"auth_method": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Authentication method of the enrollment, `authenticator`, `guardian` or `sms`",
+						},
+						"enrolled_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the user enrolled",
+						},
+						"last_auth": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the enrollment was last used",
+						},
+					},
+				},
+			},
+			"identities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Identities of the user, including the ones linked to it",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity provider of the identity, e.g. `auth0` or `google-oauth2`",
+						},
+						"connection": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the connection of the identity",
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the user within the identity provider",
+						},
+						"is_social": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the identity comes from a social provider",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -143,6 +297,7 @@ func readUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.D
 	_ = d.Set("phone_verified", u.PhoneVerified)
 	_ = d.Set("blocked", u.Blocked)
 	_ = d.Set("picture", u.Picture)
+	_ = d.Set("identities", flattenUserIdentities(u.Identities))
 
 	userMeta, err := structure.FlattenJsonToString(u.UserMetadata)
 	if err != nil {
@@ -167,25 +322,69 @@ func readUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.D
 		return
 	}())
 
+	// The following attributes are left untouched when the token of the
+	// provider lacks the scope to read them.
+	permissions, err := readUserPermissions(ctx, api, d.Id())
+	if err != nil && !isForbiddenError(err) {
+		return diag.FromErr(err)
+	}
+	if err == nil {
+		_ = d.Set("permissions", flattenRolePermissions(permissions))
+	}
+
+	blocks, err := api.User.Blocks(d.Id(), management.Context(ctx))
+	if err != nil && !isForbiddenError(err) {
+		return diag.FromErr(err)
+	}
+	if err == nil {
+		_ = d.Set("blocked_for", flattenUserBlocks(blocks))
+	}
+
+	enrollments, err := api.User.Enrollments(d.Id(), management.Context(ctx))
+	if err != nil && !isForbiddenError(err) {
+		return diag.FromErr(err)
+	}
+	if err == nil {
+		_ = d.Set("multifactor", flattenUserEnrollments(enrollments))
+	}
+
 	return nil
 }
 
+// isForbiddenError tells whether err is the error Auth0 returns when the
+// token lacks the scope an endpoint requires.
+func isForbiddenError(err error) bool {
+	mErr, ok := err.(management.Error)
+	return ok && mErr.Status() == http.StatusForbidden
+}
+
 func createUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	u, err := buildUser(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// The write-only password must never reach the state.
+	_ = d.Set("password_wo", "")
+
 	api := m.(*management.Management)
 	if err := api.User.Create(u, management.Context(ctx)); err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(*u.ID)
 
+	if err := sendUserPasswordChangeEmail(ctx, d, api); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.Partial(true)
 	err = assignUserRoles(ctx, d, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = assignUserPermissions(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.Partial(false)
 
 	return readUser(ctx, d, m)
@@ -196,19 +395,32 @@ func updateUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = validateUser(u); err != nil {
-		return diag.FromErr(err)
-	}
+	_ = d.Set("password_wo", "")
 	api := m.(*management.Management)
-	if userHasChange(u) {
-		if err := api.User.Update(d.Id(), u, management.Context(ctx)); err != nil {
-			return diag.FromErr(err)
+
+	// The update can take several calls, keep the previous state until they
+	// all succeeded so that the next apply sends them again.
+	d.Partial(true)
+	for _, update := range splitUserUpdate(u) {
+		if userHasChange(update) {
+			if err := api.User.Update(d.Id(), update, management.Context(ctx)); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
+	if err := sendUserPasswordChangeEmail(ctx, d, api); err != nil {
+		return diag.FromErr(err)
+	}
 	err = assignUserRoles(ctx, d, m)
 	if err != nil {
 		return diag.Errorf("failed assigning user roles. %s", err)
 	}
+	err = assignUserPermissions(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed assigning user permissions. %s", err)
+	}
+	d.Partial(false)
+
 	return readUser(ctx, d, m)
 }
 
@@ -241,6 +453,11 @@ func buildUser(d *schema.ResourceData) (u *management.User, err error) {
 	u.PhoneVerified = Bool(d, "phone_verified", IsNewResource(), HasChange())
 
 	u.Password = String(d, "password", IsNewResource(), HasChange())
+	if d.IsNewResource() || (d.HasChange("password_version") && !d.Get("password_change_email").(bool)) {
+		if password := String(d, "password_wo"); password != nil {
+			u.Password = password
+		}
+	}
 
 	u.Blocked = Bool(d, "blocked")
 	u.Picture = String(d, "picture")
@@ -258,47 +475,36 @@ func buildUser(d *schema.ResourceData) (u *management.User, err error) {
 	return u, nil
 }
 
-func validateUser(u *management.User) error {
-	var validate error
-	for _, fn := range []validateUserFunc{
-		validateNoUsernameAndPasswordSimultaneously(),
-		validateNoUsernameAndEmailVerifiedSimultaneously(),
-		validateNoPasswordAndEmailVerifiedSimultaneously(),
-	} {
-		if err := fn(u); err != nil {
-			validate = multierror.Append(validate, err)
+// splitUserUpdate splits the update of a user into the sequence of updates
+// accepted by Auth0, which refuses to change the username, the password and
+// email_verified in the same call. The other attributes are updated first
+// along with the username, then email_verified and finally the password.
+func splitUserUpdate(u *management.User) []*management.User {
+	updates := []*management.User{u}
+
+	var emailVerified, password *management.User
+	if u.EmailVerified != nil && u.Username != nil {
+		emailVerified = &management.User{
+			Connection:    u.Connection,
+			EmailVerified: u.EmailVerified,
 		}
 	}
-	return validate
-}
-
-type validateUserFunc func(*management.User) error
-
-func validateNoUsernameAndPasswordSimultaneously() validateUserFunc {
-	return func(u *management.User) (err error) {
-		if u.Username != nil && u.Password != nil {
-			err = fmt.Errorf("Cannot update username and password simultaneously")
+	if u.Password != nil && (u.Username != nil || u.EmailVerified != nil) {
+		password = &management.User{
+			Connection: u.Connection,
+			Password:   u.Password,
 		}
-		return
 	}
-}
 
-func validateNoUsernameAndEmailVerifiedSimultaneously() validateUserFunc {
-	return func(u *management.User) (err error) {
-		if u.Username != nil && u.EmailVerified != nil {
-			err = fmt.Errorf("Cannot update username and email_verified simultaneously")
-		}
-		return
+	if emailVerified != nil {
+		u.EmailVerified = nil
+		updates = append(updates, emailVerified)
 	}
-}
-
-func validateNoPasswordAndEmailVerifiedSimultaneously() validateUserFunc {
-	return func(u *management.User) (err error) {
-		if u.Password != nil && u.EmailVerified != nil {
-			err = fmt.Errorf("Cannot update password and email_verified simultaneously")
-		}
-		return
+	if password != nil {
+		u.Password = nil
+		updates = append(updates, password)
 	}
+	return updates
 }
 
 func assignUserRoles(ctx context.Context, d *schema.ResourceData, m interface{}) error {
@@ -346,6 +552,190 @@ func assignUserRoles(ctx context.Context, d *schema.ResourceData, m interface{})
 	return nil
 }
 
+func assignUserPermissions(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+
+	add, rm := Diff(d, "permissions")
+
+	api := m.(*management.Management)
+
+	if len(rm) > 0 {
+		err := api.User.RemovePermissions(d.Id(), expandUserPermissions(rm), management.Context(ctx))
+		if err != nil {
+			// Ignore 404 errors as the resource server may have been deleted
+			// prior to removing its permissions from the user.
+			if mErr, ok := err.(management.Error); ok {
+				if mErr.Status() != http.StatusNotFound {
+					return err
+				}
+			} else {
+				return err
+			}
+		}
+	}
+
+	if len(add) > 0 {
+		err := api.User.AssignPermissions(d.Id(), expandUserPermissions(add), management.Context(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandUserPermissions(l []interface{}) (permissions []*management.Permission) {
+	for _, v := range l {
+		permission := v.(map[string]interface{})
+		permissions = append(permissions, &management.Permission{
+			Name:                     auth0.String(permission["name"].(string)),
+			ResourceServerIdentifier: auth0.String(permission["resource_server_identifier"].(string)),
+		})
+	}
+	return
+}
+
+type userPermission struct {
+	management.Permission
+	Sources []*userPermissionSource `json:"sources,omitempty"`
+}
+
+type userPermissionSource struct {
+	SourceID   *string `json:"source_id,omitempty"`
+	SourceName *string `json:"source_name,omitempty"`
+	SourceType *string `json:"source_type,omitempty"`
+}
+
+type userPermissionList struct {
+	management.List
+	Permissions []*userPermission `json:"permissions"`
+}
+
+// isDirect reports whether the permission is assigned to the user directly,
+// rather than only through one of its roles.
+func (p *userPermission) isDirect() bool {
+	if len(p.Sources) == 0 {
+		return true
+	}
+	for _, source := range p.Sources {
+		if auth0.StringValue(source.SourceType) == "DIRECT" {
+			return true
+		}
+	}
+	return false
+}
+
+// readUserPermissions reads, page by page, the permissions assigned directly
+// to the user.
+func readUserPermissions(ctx context.Context, api *management.Management, id string) ([]*management.Permission, error) {
+	var permissions []*management.Permission
+
+	var page int
+	for {
+		var l *userPermissionList
+		err := api.Request("GET", api.URI("users", id, "permissions"), &l, management.Context(ctx),
+			management.Page(page), management.PerPage(50), management.IncludeTotals(true))
+		if err != nil {
+			return nil, err
+		}
+		for _, permission := range l.Permissions {
+			if permission.isDirect() {
+				permissions = append(permissions, &permission.Permission)
+			}
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	return permissions, nil
+}
+
+// sendUserPasswordChangeEmail emails the user a link to change their password
+// when password_version changed and password_change_email is enabled.
+func sendUserPasswordChangeEmail(ctx context.Context, d *schema.ResourceData, api *management.Management) error {
+	if !d.Get("password_change_email").(bool) || !d.HasChange("password_version") {
+		return nil
+	}
+
+	// The email is sent by the Authentication API, which is served from the
+	// same domain as the Management API.
+	uri, err := url.Parse(api.URI())
+	if err != nil {
+		return err
+	}
+	uri.Path = "/dbconnections/change_password"
+
+	return requestPasswordChangeEmail(ctx, uri.String(), d.Get("email").(string), d.Get("connection_name").(string))
+}
+
+func requestPasswordChangeEmail(ctx context.Context, uri, email, connection string) error {
+	b, err := json.Marshal(map[string]string{
+		"email":      email,
+		"connection": connection,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", uri, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to send the password change email: %s", res.Status)
+	}
+	return nil
+}
+
+func flattenUserIdentities(identities []*management.UserIdentity) []interface{} {
+	var l []interface{}
+	for _, identity := range identities {
+		l = append(l, map[string]interface{}{
+			"provider":   identity.GetProvider(),
+			"connection": identity.GetConnection(),
+			"user_id":    identity.GetUserID(),
+			"is_social":  identity.GetIsSocial(),
+		})
+	}
+	return l
+}
+
+func flattenUserBlocks(blocks []*management.UserBlock) []interface{} {
+	var l []interface{}
+	for _, block := range blocks {
+		l = append(l, map[string]interface{}{
+			"identifier": block.GetIdentifier(),
+			"ip":         block.GetIP(),
+		})
+	}
+	return l
+}
+
+func flattenUserEnrollments(enrollments []*management.UserEnrollment) []interface{} {
+	var l []interface{}
+	for _, enrollment := range enrollments {
+		l = append(l, map[string]interface{}{
+			"id":          enrollment.GetID(),
+			"status":      enrollment.GetStatus(),
+			"type":        enrollment.GetType(),
+			"name":        enrollment.GetName(),
+			"auth_method": enrollment.GetAuthMethod(),
+			"enrolled_at": formatTime(enrollment.EnrolledAt),
+			"last_auth":   formatTime(enrollment.LastAuth),
+		})
+	}
+	return l
+}
+
 func userHasChange(u *management.User) bool {
 	// hacky but we need to tell if an empty json is sent to the api.
 	return u.String() != "{}"
@@ -0,0 +1,153 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newUserIdentityLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createUserIdentityLink,
+		ReadContext:   readUserIdentityLink,
+		DeleteContext: deleteUserIdentityLink,
+		Importer: &schema.ResourceImporter{
+			StateContext: importUserIdentityLink,
+		},
+		Description: `With this resource, you can link the identity of a secondary user account to a primary user, so
+that both can log in as the primary user. The identity is unlinked when the resource is destroyed, which turns it back
+into a separate user account.
+
+Linking removes the secondary user account, so it shouldn't also be managed with the ` + "`auth0_user`" + ` resource.
+
+The resource can be imported using the ` + "`primary_user_id::secondary_provider::secondary_user_id`" + ` format.`,
+		Schema: map[string]*schema.Schema{
+			"primary_user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the primary user, e.g. `auth0|5f7c8ec7c33c6c004bbafe82`",
+			},
+			"secondary_provider": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identity provider of the secondary user account, e.g. `auth0` or `google-oauth2`",
+			},
+			"secondary_user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "ID of the secondary user account, with or without the provider prefix, e.g. " +
+					"`google-oauth2|1234567890` or `1234567890`",
+				DiffSuppressFunc: func(_, old, new string, d *schema.ResourceData) bool {
+					provider := d.Get("secondary_provider").(string)
+					return userIdentityID(provider, old) == userIdentityID(provider, new)
+				},
+			},
+			"connection_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "ID of the connection of the secondary user account, required when the tenant has " +
+					"more than one database connection",
+			},
+		},
+	}
+}
+
+func createUserIdentityLink(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	primaryUserID := d.Get("primary_user_id").(string)
+	provider := d.Get("secondary_provider").(string)
+	secondaryUserID := userIdentityID(provider, d.Get("secondary_user_id").(string))
+
+	globalMutex.Lock("user:" + primaryUserID)
+	defer globalMutex.Unlock("user:" + primaryUserID)
+
+	api := m.(*management.Management)
+	_, err := api.User.Link(primaryUserID, &management.UserIdentityLink{
+		ConnectionID: String(d, "connection_id"),
+		UserID:       &secondaryUserID,
+		Provider:     &provider,
+	}, management.Context(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(primaryUserID + "::" + provider + "::" + secondaryUserID)
+
+	return readUserIdentityLink(ctx, d, m)
+}
+
+func readUserIdentityLink(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	primaryUserID, provider, secondaryUserID, err := parseUserIdentityLinkID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	api := m.(*management.Management)
+	u, err := api.User.Read(primaryUserID, management.Context(ctx))
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+
+	for _, identity := range u.Identities {
+		if identity.GetProvider() == provider && identity.GetUserID() == secondaryUserID {
+			_ = d.Set("primary_user_id", primaryUserID)
+			_ = d.Set("secondary_provider", provider)
+			return nil
+		}
+	}
+
+	// The identity was unlinked outside of Terraform.
+	d.SetId("")
+	return nil
+}
+
+func deleteUserIdentityLink(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	primaryUserID, provider, secondaryUserID, err := parseUserIdentityLinkID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	globalMutex.Lock("user:" + primaryUserID)
+	defer globalMutex.Unlock("user:" + primaryUserID)
+
+	api := m.(*management.Management)
+	err = api.Request("DELETE", api.URI("users", primaryUserID, "identities", provider, secondaryUserID), nil,
+		management.Context(ctx))
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	return nil
+}
+
+func importUserIdentityLink(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	primaryUserID, provider, secondaryUserID, err := parseUserIdentityLinkID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("primary_user_id", primaryUserID)
+	_ = d.Set("secondary_provider", provider)
+	_ = d.Set("secondary_user_id", secondaryUserID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseUserIdentityLinkID(id string) (primaryUserID, provider, secondaryUserID string, err error) {
+	parts := strings.Split(id, "::")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected primary_user_id::secondary_provider::secondary_user_id", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// userIdentityID strips the provider prefix from a user ID, as identities
+// only hold the ID of the user within the identity provider.
+func userIdentityID(provider, userID string) string {
+	return strings.TrimPrefix(userID, provider+"|")
+}
@@ -0,0 +1,122 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func TestAccUserIdentityLink(t *testing.T) {
+	rand := random.String(6)
+
+	// The secondary user is removed once linked, so it can't be managed with
+	// an auth0_user resource.
+	createSecondaryUser := func() {
+		err := testAuth0ApiClient().User.Create(&management.User{
+			ID:         auth0.String("secondary-" + rand),
+			Connection: auth0.String("Username-Password-Authentication"),
+			Email:      auth0.String("secondary-" + rand + "@acceptance.test.com"),
+			Password:   auth0.String("passpass$12$12"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Unlinking restores the secondary user, which is then deleted.
+	deleteSecondaryUser := func(s *terraform.State) error {
+		api := testAuth0ApiClient()
+		id := "auth0|secondary-" + rand
+		if _, err := api.User.Read(id); err != nil {
+			return fmt.Errorf("expected the secondary user %s to be restored when unlinked: %w", id, err)
+		}
+		return api.User.Delete(id)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      deleteSecondaryUser,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: createSecondaryUser,
+				Config: random.Template(`
+resource "auth0_user" "primary" {
+	connection_name = "Username-Password-Authentication"
+	email = "primary-{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+}
+
+resource "auth0_user_identity_link" "my_link" {
+	primary_user_id = auth0_user.primary.id
+	secondary_provider = "auth0"
+	secondary_user_id = "auth0|secondary-{{.random}}"
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_user_identity_link.my_link", "primary_user_id", "auth0_user.primary", "id"),
+					resource.TestCheckResourceAttr("auth0_user_identity_link.my_link", "secondary_provider", "auth0"),
+					random.TestCheckResourceAttr("auth0_user_identity_link.my_link", "secondary_user_id", "auth0|secondary-{{.random}}", rand),
+				),
+			},
+			{
+				ResourceName:            "auth0_user_identity_link.my_link",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secondary_user_id"},
+			},
+		},
+	})
+}
+
+func TestParseUserIdentityLinkID(t *testing.T) {
+	primaryUserID, provider, secondaryUserID, err := parseUserIdentityLinkID("auth0|abc::google-oauth2::123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primaryUserID != "auth0|abc" || provider != "google-oauth2" || secondaryUserID != "123" {
+		t.Errorf("unexpected primary_user_id %q, secondary_provider %q and secondary_user_id %q", primaryUserID, provider, secondaryUserID)
+	}
+	for _, id := range []string{"auth0|abc", "auth0|abc::auth0", "auth0|abc::::123", "::auth0::123", "a::b::c::d"} {
+		if _, _, _, err := parseUserIdentityLinkID(id); err == nil {
+			t.Errorf("expected an error for ID %q", id)
+		}
+	}
+}
+
+func TestUserIdentityID(t *testing.T) {
+	for _, tc := range []struct {
+		provider, userID, expected string
+	}{
+		{"google-oauth2", "google-oauth2|123", "123"},
+		{"google-oauth2", "123", "123"},
+		{"auth0", "google-oauth2|123", "google-oauth2|123"},
+	} {
+		if id := userIdentityID(tc.provider, tc.userID); id != tc.expected {
+			t.Errorf("expected %q for %q, got %q", tc.expected, tc.userID, id)
+		}
+	}
+}
+
+func TestFlattenUserIdentities(t *testing.T) {
+	identities := flattenUserIdentities([]*management.UserIdentity{
+		{
+			Provider:   auth0.String("google-oauth2"),
+			Connection: auth0.String("google-oauth2"),
+			UserID:     auth0.String("123"),
+			IsSocial:   auth0.Bool(true),
+		},
+	})
+	if len(identities) != 1 {
+		t.Fatalf("expected one identity, got %v", identities)
+	}
+	identity := identities[0].(map[string]interface{})
+	if identity["provider"] != "google-oauth2" || identity["user_id"] != "123" || identity["is_social"] != true {
+		t.Errorf("unexpected identity %v", identity)
+	}
+}
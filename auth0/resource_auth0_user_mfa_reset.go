@@ -0,0 +1,106 @@
+package auth0
+
+import (
+	"context"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newUserMFAReset() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createUserMFAReset,
+		ReadContext:   readUserMFAReset,
+		UpdateContext: updateUserMFAReset,
+		DeleteContext: deleteUserMFAReset,
+		Description: `With this resource, you can reset the MFA of a user, e.g. after they lost their device. The
+Guardian enrollments of the user are deleted when the resource is created, and again every time the content of
+` + "`trigger`" + ` changes, so that the user enrolls again on their next login.
+
+Destroying the resource doesn't restore the enrollments.`,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user to reset the MFA of",
+			},
+			"trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: "Arbitrary map, e.g. holding a support ticket reference. Changing its content " +
+					"resets the MFA of the user again",
+			},
+			"invalidate_remember_browser": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Whether the browsers the user chose to be remembered in are asked for MFA again " +
+					"as well. Enabling it on an existing resource asks them right away",
+			},
+		},
+	}
+}
+
+func createUserMFAReset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	api := m.(*management.Management)
+	if err := resetUserMFA(ctx, api, userID, d.Get("invalidate_remember_browser").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resource.UniqueId())
+	return readUserMFAReset(ctx, d, m)
+}
+
+func readUserMFAReset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	if _, err := api.User.Enrollments(d.Get("user_id").(string), management.Context(ctx)); err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	return nil
+}
+
+func updateUserMFAReset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	userID := d.Get("user_id").(string)
+	invalidateRememberBrowser := d.Get("invalidate_remember_browser").(bool)
+	switch {
+	case d.HasChange("trigger"):
+		if err := resetUserMFA(ctx, api, userID, invalidateRememberBrowser); err != nil {
+			return diag.FromErr(err)
+		}
+	case d.HasChange("invalidate_remember_browser") && invalidateRememberBrowser:
+		// Enabling it applies right away, without resetting the MFA again.
+		if err := api.User.InvalidateRememberBrowser(userID, management.Context(ctx)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return readUserMFAReset(ctx, d, m)
+}
+
+func deleteUserMFAReset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// resetUserMFA deletes the Guardian enrollments of the user.
+func resetUserMFA(ctx context.Context, api *management.Management, userID string, invalidateRememberBrowser bool) error {
+	enrollments, err := api.User.Enrollments(userID, management.Context(ctx))
+	if err != nil {
+		return err
+	}
+	for _, enrollment := range enrollments {
+		err := api.Guardian.Enrollment.Delete(enrollment.GetID(), management.Context(ctx))
+		if err != nil {
+			return err
+		}
+	}
+	if invalidateRememberBrowser {
+		return api.User.InvalidateRememberBrowser(userID, management.Context(ctx))
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package auth0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+const testAccUserMFAResetConfig = `
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	email = "mfa-reset-{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+}
+
+resource auth0_user_mfa_reset reset {
+	user_id = auth0_user.user.id
+	invalidate_remember_browser = {{.invalidate}}
+	trigger = {
+		ticket = "{{.ticket}}"
+	}
+}
+`
+
+func TestAccUserMFAReset(t *testing.T) {
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.TemplateMap(testAccUserMFAResetConfig, map[string]string{"random": rand, "ticket": "SUP-1", "invalidate": "true"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_user_mfa_reset.reset", "user_id", "auth0_user.user", "id"),
+					resource.TestCheckResourceAttr("auth0_user.user", "multifactor.#", "0"),
+				),
+			},
+			{
+				Config: random.TemplateMap(testAccUserMFAResetConfig, map[string]string{"random": rand, "ticket": "SUP-2", "invalidate": "false"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user_mfa_reset.reset", "trigger.ticket", "SUP-2"),
+					resource.TestCheckResourceAttr("auth0_user_mfa_reset.reset", "invalidate_remember_browser", "false"),
+				),
+			},
+			{
+				Config: random.TemplateMap(testAccUserMFAResetConfig, map[string]string{"random": rand, "ticket": "SUP-2", "invalidate": "true"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user_mfa_reset.reset", "invalidate_remember_browser", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenUserEnrollments(t *testing.T) {
+	enrolledAt := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	enrollments := flattenUserEnrollments([]*management.UserEnrollment{
+		{
+			ID:         auth0.String("dev_123"),
+			Status:     auth0.String("confirmed"),
+			Type:       auth0.String("pn"),
+			Name:       auth0.String("iPhone"),
+			AuthMethod: auth0.String("guardian"),
+			EnrolledAt: &enrolledAt,
+		},
+	})
+	if len(enrollments) != 1 {
+		t.Fatalf("expected one enrollment, got %v", enrollments)
+	}
+	enrollment := enrollments[0].(map[string]interface{})
+	if enrollment["id"] != "dev_123" || enrollment["enrolled_at"] != "2021-03-04T05:06:07Z" || enrollment["last_auth"] != "" {
+		t.Errorf("unexpected enrollment %v", enrollment)
+	}
+}
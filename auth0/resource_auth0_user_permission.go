@@ -0,0 +1,141 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func newUserPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createUserPermission,
+		ReadContext:   readUserPermission,
+		DeleteContext: deleteUserPermission,
+		Importer: &schema.ResourceImporter{
+			StateContext: importUserPermission,
+		},
+		Description: `With this resource, you can assign a permission (scope) directly to a user, outside of its roles.
+It shouldn't be used together with the ` + "`permissions`" + ` attribute of the ` + "`auth0_user`" + ` resource of the same user.
+
+The resource can be imported using the ` + "`user_id::resource_server_identifier::permission`" + ` format.`,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user the permission is assigned to",
+			},
+			"resource_server_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for the resource server the permission belongs to",
+			},
+			"permission": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the permission (scope)",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the permission",
+			},
+			"resource_server_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource server name",
+			},
+		},
+	}
+}
+
+func createUserPermission(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	identifier := d.Get("resource_server_identifier").(string)
+	name := d.Get("permission").(string)
+
+	api := m.(*management.Management)
+	err := api.User.AssignPermissions(userID, []*management.Permission{
+		{
+			Name:                     auth0.String(name),
+			ResourceServerIdentifier: auth0.String(identifier),
+		},
+	}, management.Context(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(userID + "::" + identifier + "::" + name)
+
+	return readUserPermission(ctx, d, m)
+}
+
+func readUserPermission(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID, identifier, name, err := parseUserPermissionID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	api := m.(*management.Management)
+	permissions, err := readUserPermissions(ctx, api, userID)
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+
+	for _, permission := range permissions {
+		if permission.GetResourceServerIdentifier() == identifier && permission.GetName() == name {
+			_ = d.Set("user_id", userID)
+			_ = d.Set("resource_server_identifier", identifier)
+			_ = d.Set("permission", name)
+			_ = d.Set("description", permission.Description)
+			_ = d.Set("resource_server_name", permission.ResourceServerName)
+			return nil
+		}
+	}
+
+	// The permission was removed from the user outside of Terraform.
+	d.SetId("")
+	return nil
+}
+
+func deleteUserPermission(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID, identifier, name, err := parseUserPermissionID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	api := m.(*management.Management)
+	err = api.User.RemovePermissions(userID, []*management.Permission{
+		{
+			Name:                     auth0.String(name),
+			ResourceServerIdentifier: auth0.String(identifier),
+		},
+	}, management.Context(ctx))
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	return nil
+}
+
+func importUserPermission(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := parseUserPermissionID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseUserPermissionID(id string) (userID, identifier, name string, err error) {
+	parts := strings.Split(id, "::")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected user_id::resource_server_identifier::permission", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,60 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUserPermission(t *testing.T) {
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccUserPermissionsResourceServer+`
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	email = "permission-{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+}
+
+resource auth0_user_permission stop_bullets {
+	user_id = auth0_user.user.id
+	resource_server_identifier = auth0_resource_server.matrix.identifier
+	permission = "stop:bullets"
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_user_permission.stop_bullets", "user_id", "auth0_user.user", "id"),
+					resource.TestCheckResourceAttr("auth0_user_permission.stop_bullets", "permission", "stop:bullets"),
+					resource.TestCheckResourceAttr("auth0_user_permission.stop_bullets", "description", "Stop bullets"),
+					random.TestCheckResourceAttr("auth0_user_permission.stop_bullets", "resource_server_name", "User Permissions - Acceptance Test - {{.random}}", rand),
+				),
+			},
+			{
+				ResourceName:      "auth0_user_permission.stop_bullets",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseUserPermissionID(t *testing.T) {
+	userID, identifier, name, err := parseUserPermissionID("auth0|abc::https://api.example.com/::read:messages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userID != "auth0|abc" || identifier != "https://api.example.com/" || name != "read:messages" {
+		t.Errorf("unexpected user_id %q, resource_server_identifier %q and permission %q", userID, identifier, name)
+	}
+	for _, id := range []string{"auth0|abc", "auth0|abc::https://api.example.com/", "::https://api.example.com/::read:messages", "a::b::c::d"} {
+		if _, _, _, err := parseUserPermissionID(id); err == nil {
+			t.Errorf("expected an error for ID %q", id)
+		}
+	}
+}
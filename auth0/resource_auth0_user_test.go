@@ -1,14 +1,21 @@
 package auth0
 
 import (
+	"context"
+	"encoding/json"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
+	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/management"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
 )
@@ -102,6 +109,11 @@ EOF
 					resource.TestCheckResourceAttr("auth0_user.user", "connection_name", "Username-Password-Authentication"),
 					resource.TestCheckResourceAttr("auth0_user.user", "roles.#", "0"),
 					resource.TestCheckResourceAttr("auth0_user.user", "picture", "https://www.example.com/picture.jpg"),
+					resource.TestCheckResourceAttr("auth0_user.user", "identities.#", "1"),
+					resource.TestCheckResourceAttr("auth0_user.user", "identities.0.provider", "auth0"),
+					resource.TestCheckResourceAttr("auth0_user.user", "identities.0.connection", "Username-Password-Authentication"),
+					resource.TestCheckResourceAttr("auth0_user.user", "identities.0.user_id", rand),
+					resource.TestCheckResourceAttr("auth0_user.user", "identities.0.is_social", "false"),
 				),
 			},
 			{
@@ -285,8 +297,287 @@ resource auth0_user auth0_user_change_username {
   password = "MyPass123456$"
 }
 `, rand),
-				ExpectError: regexp.MustCompile("Cannot update username and password simultaneously"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_user.auth0_user_change_username", "username", "user_{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_user.auth0_user_change_username", "password", "MyPass123456$"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUserPermissionsResourceServer = `
+resource auth0_resource_server matrix {
+	name = "User Permissions - Acceptance Test - {{.random}}"
+	identifier = "https://{{.random}}.matrix.com/"
+	scopes {
+		value = "stop:bullets"
+		description = "Stop bullets"
+	}
+	scopes {
+		value = "bring:peace"
+		description = "Bring peace"
+	}
+}
+`
+
+func TestAccUserPermissions(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccUserPermissionsResourceServer+`
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	email = "permissions-{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+	permissions {
+		name = "stop:bullets"
+		resource_server_identifier = auth0_resource_server.matrix.identifier
+	}
+	permissions {
+		name = "bring:peace"
+		resource_server_identifier = auth0_resource_server.matrix.identifier
+	}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user.user", "permissions.#", "2"),
+				),
+			},
+			{
+				Config: random.Template(testAccUserPermissionsResourceServer+`
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	email = "permissions-{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+	permissions {
+		name = "bring:peace"
+		resource_server_identifier = auth0_resource_server.matrix.identifier
+	}
+}
+`, rand),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user.user", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("auth0_user.user", "permissions.*", map[string]string{
+						"name":        "bring:peace",
+						"description": "Bring peace",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestUserPermissionIsDirect(t *testing.T) {
+	for _, tc := range []struct {
+		sources  []string
+		expected bool
+	}{
+		{nil, true},
+		{[]string{"DIRECT"}, true},
+		{[]string{"ROLE", "DIRECT"}, true},
+		{[]string{"ROLE"}, false},
+	} {
+		p := &userPermission{}
+		for _, sourceType := range tc.sources {
+			p.Sources = append(p.Sources, &userPermissionSource{SourceType: auth0.String(sourceType)})
+		}
+		if p.isDirect() != tc.expected {
+			t.Errorf("expected a permission with sources %v to be direct: %t", tc.sources, tc.expected)
+		}
+	}
+}
+
+func TestAccUserPasswordWriteOnly(t *testing.T) {
+
+	rand := random.String(6)
+
+	config := `
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	email = "password-wo-{{.random}}@acceptance.test.com"
+	password_wo = "{{.password}}"
+	password_version = {{.version}}
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.TemplateMap(config, map[string]string{"random": rand, "password": "passpass$12$12", "version": "1"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user.user", "password_wo", ""),
+					resource.TestCheckResourceAttr("auth0_user.user", "password_version", "1"),
+				),
+			},
+			{
+				// Changing the password alone doesn't send it.
+				Config:   random.TemplateMap(config, map[string]string{"random": rand, "password": "passpass$34$34", "version": "1"}),
+				PlanOnly: true,
+			},
+			{
+				Config: random.TemplateMap(config, map[string]string{"random": rand, "password": "passpass$34$34", "version": "2"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user.user", "password_wo", ""),
+					resource.TestCheckResourceAttr("auth0_user.user", "password_version", "2"),
+				),
 			},
 		},
 	})
 }
+
+func TestBuildUserPasswordWriteOnly(t *testing.T) {
+	raw := map[string]interface{}{
+		"connection_name":       "Username-Password-Authentication",
+		"password_wo":           "passpass$12$12",
+		"password_version":      1,
+		"password_change_email": true,
+	}
+
+	d := schema.TestResourceDataRaw(t, newUser().Schema, raw)
+	d.MarkNewResource()
+	u, err := buildUser(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.GetPassword() != "passpass$12$12" {
+		t.Errorf("expected the password to be sent when the user is created")
+	}
+
+	// Once created, the password is replaced by the password change email.
+	d = schema.TestResourceDataRaw(t, newUser().Schema, raw)
+	u, err = buildUser(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Password != nil {
+		t.Errorf("expected the password not to be sent along the password change email")
+	}
+
+	raw["password_change_email"] = false
+	d = schema.TestResourceDataRaw(t, newUser().Schema, raw)
+	u, err = buildUser(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.GetPassword() != "passpass$12$12" {
+		t.Errorf("expected the password to be sent when password_version changes")
+	}
+}
+
+func TestRequestPasswordChangeEmail(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/dbconnections/change_password" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte("We've just sent you an email to reset your password."))
+	}))
+	defer server.Close()
+
+	err := requestPasswordChangeEmail(context.Background(), server.URL+"/dbconnections/change_password",
+		"john@example.com", "Username-Password-Authentication")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body["email"] != "john@example.com" || body["connection"] != "Username-Password-Authentication" {
+		t.Errorf("unexpected request body %v", body)
+	}
+
+	err = requestPasswordChangeEmail(context.Background(), server.URL+"/missing", "john@example.com", "db")
+	if err == nil {
+		t.Errorf("expected an error when the request fails")
+	}
+}
+
+func TestSplitUserUpdate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		user     *management.User
+		expected []*management.User
+	}{
+		{
+			"no conflicts",
+			&management.User{Connection: auth0.String("db"), Username: auth0.String("john"), Email: auth0.String("john@example.com")},
+			[]*management.User{
+				{Connection: auth0.String("db"), Username: auth0.String("john"), Email: auth0.String("john@example.com")},
+			},
+		},
+		{
+			"username and password",
+			&management.User{Connection: auth0.String("db"), Username: auth0.String("john"), Password: auth0.String("secret")},
+			[]*management.User{
+				{Connection: auth0.String("db"), Username: auth0.String("john")},
+				{Connection: auth0.String("db"), Password: auth0.String("secret")},
+			},
+		},
+		{
+			"password and email_verified",
+			&management.User{Connection: auth0.String("db"), Password: auth0.String("secret"), EmailVerified: auth0.Bool(true)},
+			[]*management.User{
+				{Connection: auth0.String("db"), EmailVerified: auth0.Bool(true)},
+				{Connection: auth0.String("db"), Password: auth0.String("secret")},
+			},
+		},
+		{
+			"username, password and email_verified",
+			&management.User{Connection: auth0.String("db"), Username: auth0.String("john"), Password: auth0.String("secret"), EmailVerified: auth0.Bool(true)},
+			[]*management.User{
+				{Connection: auth0.String("db"), Username: auth0.String("john")},
+				{Connection: auth0.String("db"), EmailVerified: auth0.Bool(true)},
+				{Connection: auth0.String("db"), Password: auth0.String("secret")},
+			},
+		},
+	} {
+		updates := splitUserUpdate(tc.user)
+		if len(updates) != len(tc.expected) {
+			t.Errorf("%s: expected %d updates, got %v", tc.name, len(tc.expected), updates)
+			continue
+		}
+		for i, update := range updates {
+			if update.String() != tc.expected[i].String() {
+				t.Errorf("%s: expected update %d to be %s, got %s", tc.name, i, tc.expected[i], update)
+			}
+		}
+	}
+}
+
+func TestReadUserForbiddenComputedAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/roles"):
+			_, _ = w.Write([]byte(`{"roles": []}`))
+		case strings.HasSuffix(r.URL.Path, "/permissions"),
+			strings.HasSuffix(r.URL.Path, "/enrollments"),
+			strings.Contains(r.URL.Path, "/user-blocks/"):
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"statusCode": 403, "error": "Forbidden", "message": "Insufficient scope"}`))
+		default:
+			_, _ = w.Write([]byte(`{"user_id": "auth0|123", "email": "john@example.com"}`))
+		}
+	}))
+	defer server.Close()
+
+	api, err := management.New(server.URL, management.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, newUser().Schema, map[string]interface{}{})
+	d.SetId("auth0|123")
+	if diags := readUser(context.Background(), d, api); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if email := d.Get("email"); email != "john@example.com" {
+		t.Errorf("expected the user to be read, got the email %v", email)
+	}
+	if n := d.Get("multifactor.#"); n != 0 {
+		t.Errorf("expected multifactor to be left unset, got %v enrollments", n)
+	}
+}
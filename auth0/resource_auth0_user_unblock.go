@@ -0,0 +1,94 @@
+package auth0
+
+import (
+	"context"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/flow"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newUserUnblock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createUserUnblock,
+		ReadContext:   readUserUnblock,
+		UpdateContext: updateUserUnblock,
+		DeleteContext: deleteUserUnblock,
+		Description: `With this resource, you can unblock a user whose logins were blocked due to too many failed
+attempts. The user is unblocked when the resource is created, and again every time the content of ` + "`trigger`" + `
+changes. It doesn't lift the blocks set with the ` + "`blocked`" + ` attribute of the ` + "`auth0_user`" + ` resource.
+
+Destroying the resource doesn't block the user again.`,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user to unblock",
+			},
+			"trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: "Arbitrary map, e.g. holding a support ticket reference. Changing its content " +
+					"unblocks the user again",
+			},
+			"blocked_for": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Blocks of the user which remain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the blocked user, e.g. its email or username",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address the logins are blocked from",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createUserUnblock(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	api := m.(*management.Management)
+	if err := api.User.Unblock(userID, management.Context(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resource.UniqueId())
+	return readUserUnblock(ctx, d, m)
+}
+
+func readUserUnblock(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	blocks, err := api.User.Blocks(d.Get("user_id").(string), management.Context(ctx))
+	if err != nil {
+		return flow.DefaultManagementError(err, d)
+	}
+	_ = d.Set("blocked_for", flattenUserBlocks(blocks))
+	return nil
+}
+
+func updateUserUnblock(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("trigger") {
+		api := m.(*management.Management)
+		if err := api.User.Unblock(d.Get("user_id").(string), management.Context(ctx)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return readUserUnblock(ctx, d, m)
+}
+
+func deleteUserUnblock(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
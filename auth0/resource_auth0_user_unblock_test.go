@@ -0,0 +1,49 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccUserUnblockConfig = `
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	email = "unblock-{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+}
+
+resource auth0_user_unblock unblock {
+	user_id = auth0_user.user.id
+	trigger = {
+		ticket = "{{.ticket}}"
+	}
+}
+`
+
+func TestAccUserUnblock(t *testing.T) {
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: random.TemplateMap(testAccUserUnblockConfig, map[string]string{"random": rand, "ticket": "SUP-1"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_user_unblock.unblock", "user_id", "auth0_user.user", "id"),
+					resource.TestCheckResourceAttr("auth0_user_unblock.unblock", "blocked_for.#", "0"),
+					resource.TestCheckResourceAttr("auth0_user.user", "blocked_for.#", "0"),
+				),
+			},
+			{
+				Config: random.TemplateMap(testAccUserUnblockConfig, map[string]string{"random": rand, "ticket": "SUP-2"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user_unblock.unblock", "trigger.ticket", "SUP-2"),
+					resource.TestCheckResourceAttr("auth0_user_unblock.unblock", "blocked_for.#", "0"),
+				),
+			},
+		},
+	})
+}
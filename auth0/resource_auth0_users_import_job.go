@@ -0,0 +1,309 @@
+package auth0
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// usersImportFileMaxSize is the maximum size of a users file accepted by
+// Auth0.
+const usersImportFileMaxSize = 500 * 1024
+
+type usersImportJob struct {
+	management.Job
+	Summary *usersImportJobSummary `json:"summary,omitempty"`
+}
+
+type usersImportJobSummary struct {
+	Failed   *int `json:"failed,omitempty"`
+	Updated  *int `json:"updated,omitempty"`
+	Inserted *int `json:"inserted,omitempty"`
+	Total    *int `json:"total,omitempty"`
+}
+
+type usersImportJobError struct {
+	User   map[string]interface{}       `json:"user,omitempty"`
+	Errors []*usersImportJobErrorDetail `json:"errors,omitempty"`
+}
+
+type usersImportJobErrorDetail struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Path    *string `json:"path,omitempty"`
+}
+
+func newUsersImportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createUsersImportJob,
+		ReadContext:   readUsersImportJob,
+		DeleteContext: deleteUsersImportJob,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Description: `
+With this resource, you can import users from a JSON file into a database connection. The import job is submitted
+when the resource is created and waited for until it completes. Changing the content of the file submits a new job.
+
+Destroying the resource doesn't remove the imported users. Auth0 only keeps the jobs for a limited time, after which
+the last known results are kept in the state.`,
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database connection the users are imported into",
+			},
+			"users_file": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Path to a JSON file holding the array of users to import. Only the SHA256 hash of " +
+					"the file content is stored in the state",
+				ValidateFunc: validateUsersImportFile,
+				StateFunc:    usersImportFileHash,
+			},
+			"upsert": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether users which already exist are updated instead of failing to be imported",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "User defined string returned in the status of the job, to correlate jobs",
+			},
+			"send_completion_email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the tenant owners receive an email once the job completes",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the job",
+			},
+			"inserted": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users inserted",
+			},
+			"updated": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users updated",
+			},
+			"failed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users which failed to be imported",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users in the file",
+			},
+			"errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Errors of the users which failed to be imported, one per error",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON-encoded user which failed to be imported",
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error code",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error message",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Path of the attribute in error",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createUsersImportJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	users, err := readUsersImportFile(d.Get("users_file").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	j := &management.Job{
+		ConnectionID:        String(d, "connection_id"),
+		Users:               users,
+		Upsert:              Bool(d, "upsert"),
+		ExternalID:          String(d, "external_id"),
+		SendCompletionEmail: Bool(d, "send_completion_email"),
+	}
+
+	api := m.(*management.Management)
+	if err := api.Job.ImportUsers(j, management.Context(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(auth0.StringValue(j.ID))
+
+	log.Printf("[INFO] Waiting for the users import job (%s) to complete", d.Id())
+	_, err = usersImportJobStateConf(ctx, d, api).WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readUsersImportJob(ctx, d, m)
+}
+
+func usersImportJobStateConf(ctx context.Context, d *schema.ResourceData, api *management.Management) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending: []string{"pending", "processing"},
+		Target:  []string{"completed"},
+		Refresh: func() (interface{}, string, error) {
+			j, err := readUsersImportJobStatus(ctx, api, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			if j.GetStatus() == "failed" {
+				return j, "failed", fmt.Errorf("users import job %s failed", d.Id())
+			}
+			return j, j.GetStatus(), nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+	}
+}
+
+func readUsersImportJobStatus(ctx context.Context, api *management.Management, id string) (j *usersImportJob, err error) {
+	err = api.Request("GET", api.URI("jobs", id), &j, management.Context(ctx))
+	return
+}
+
+func readUsersImportJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	j, err := readUsersImportJobStatus(ctx, api, d.Id())
+	if err != nil {
+		// Auth0 forgets about jobs after a while, which doesn't undo the
+		// import, so the last known results are kept.
+		if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("connection_id", j.ConnectionID)
+	_ = d.Set("external_id", j.ExternalID)
+	_ = d.Set("status", j.Status)
+	if j.Summary != nil {
+		_ = d.Set("inserted", j.Summary.Inserted)
+		_ = d.Set("updated", j.Summary.Updated)
+		_ = d.Set("failed", j.Summary.Failed)
+		_ = d.Set("total", j.Summary.Total)
+	}
+
+	var errs []*usersImportJobError
+	if j.Summary != nil && auth0.IntValue(j.Summary.Failed) > 0 {
+		err = api.Request("GET", api.URI("jobs", d.Id(), "errors"), &errs, management.Context(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	_ = d.Set("errors", flattenUsersImportJobErrors(errs))
+
+	return nil
+}
+
+func deleteUsersImportJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func flattenUsersImportJobErrors(errs []*usersImportJobError) []interface{} {
+	var l []interface{}
+	for _, e := range errs {
+		user, _ := json.Marshal(e.User)
+		for _, err := range e.Errors {
+			l = append(l, map[string]interface{}{
+				"user":    string(user),
+				"code":    auth0.StringValue(err.Code),
+				"message": auth0.StringValue(err.Message),
+				"path":    auth0.StringValue(err.Path),
+			})
+		}
+	}
+	return l
+}
+
+// readUsersImportFile reads the array of users held by the file.
+func readUsersImportFile(path string) ([]map[string]interface{}, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > usersImportFileMaxSize {
+		return nil, fmt.Errorf("expected %q to be at most %d bytes, got %d", path, usersImportFileMaxSize, len(b))
+	}
+
+	var users []map[string]interface{}
+	if err := json.Unmarshal(b, &users); err != nil {
+		return nil, fmt.Errorf("expected %q to hold a JSON array of users: %w", path, err)
+	}
+	return users, nil
+}
+
+// validateUsersImportFile checks that the file holds a JSON array of users
+// small enough to be imported.
+func validateUsersImportFile(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	users, err := readUsersImportFile(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid %q: %w", k, err))
+		return
+	}
+	if len(users) == 0 {
+		errs = append(errs, fmt.Errorf("expected %q to hold at least one user", k))
+	}
+	return
+}
+
+func usersImportFileHash(v interface{}) string {
+	b, err := ioutil.ReadFile(v.(string))
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,141 @@
+package auth0
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alekc/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/auth0.v5"
+)
+
+func TestAccUsersImportJob(t *testing.T) {
+	rand := random.String(6)
+
+	dir, err := ioutil.TempDir("", "auth0-users-import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "users.json")
+	write := func(users string) func() {
+		return func() {
+			if err := ioutil.WriteFile(path, []byte(users), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	write(random.Template(`[
+	{"email": "import-1-{{.random}}@acceptance.test.com", "email_verified": true},
+	{"email": "import-2-{{.random}}@acceptance.test.com", "email_verified": true}
+]`, rand))()
+
+	config := random.TemplateMap(`
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Import-{{.random}}"
+	strategy = "auth0"
+}
+
+resource "auth0_users_import_job" "my_import" {
+	connection_id = auth0_connection.my_connection.id
+	users_file = "{{.path}}"
+	upsert = true
+	external_id = "acceptance-test-{{.random}}"
+	send_completion_email = false
+}
+`, map[string]string{"random": rand, "path": path})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_users_import_job.my_import", "connection_id", "auth0_connection.my_connection", "id"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.my_import", "status", "completed"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.my_import", "inserted", "2"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.my_import", "failed", "0"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.my_import", "total", "2"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.my_import", "errors.#", "0"),
+				),
+			},
+			{
+				PreConfig: write(random.Template(`[
+	{"email": "import-1-{{.random}}@acceptance.test.com", "email_verified": true},
+	{"email": "not an email"}
+]`, rand)),
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_users_import_job.my_import", "status", "completed"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.my_import", "updated", "1"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.my_import", "failed", "1"),
+					resource.TestCheckResourceAttrSet("auth0_users_import_job.my_import", "errors.0.code"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateUsersImportFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth0-users-import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, tc := range []struct {
+		name    string
+		content string
+		errs    int
+	}{
+		{"users.json", `[{"email": "john@example.com"}]`, 0},
+		{"empty.json", `[]`, 1},
+		{"object.json", `{"email": "john@example.com"}`, 1},
+		{"invalid.json", `[{"email": `, 1},
+	} {
+		path := filepath.Join(dir, tc.name)
+		if err := ioutil.WriteFile(path, []byte(tc.content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, errs := validateUsersImportFile(path, "users_file"); len(errs) != tc.errs {
+			t.Errorf("%s: expected %d errors, got %v", tc.name, tc.errs, errs)
+		}
+	}
+
+	if _, errs := validateUsersImportFile(filepath.Join(dir, "missing.json"), "users_file"); len(errs) != 1 {
+		t.Errorf("expected an error for a missing file, got %v", errs)
+	}
+	if hash := usersImportFileHash(filepath.Join(dir, "missing.json")); hash != "" {
+		t.Errorf("expected an empty hash for a missing file, got %s", hash)
+	}
+}
+
+func TestFlattenUsersImportJobErrors(t *testing.T) {
+	e := &usersImportJobError{
+		User: map[string]interface{}{"email": "john@example.com"},
+		Errors: []*usersImportJobErrorDetail{
+			{
+				Code:    auth0.String("DUPLICATED_USER"),
+				Message: auth0.String("The user already exists."),
+				Path:    auth0.String("email"),
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"user":    `{"email":"john@example.com"}`,
+			"code":    "DUPLICATED_USER",
+			"message": "The user already exists.",
+			"path":    "email",
+		},
+	}
+	if errs := flattenUsersImportJobErrors([]*usersImportJobError{e}); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected %v, got %v", expected, errs)
+	}
+}
@@ -0,0 +1,47 @@
+package auth0
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// dataSourceSchemaFromResourceSchema derives the schema of a data source from
+// the schema of the resource managing the same object, so that both can't
+// drift apart. Every attribute becomes computed, except the lookup keys which
+// are kept as required arguments. Lookup keys the resource doesn't have, such
+// as `id`, are left to the data source.
+func dataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema, lookupKeys ...string) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{}
+	for k, v := range rs {
+		s[k] = computedSchema(v)
+	}
+	for _, k := range lookupKeys {
+		if v, ok := rs[k]; ok {
+			s[k] = &schema.Schema{
+				Type:         v.Type,
+				Required:     true,
+				Description:  v.Description,
+				ValidateFunc: v.ValidateFunc,
+			}
+		}
+	}
+	return s
+}
+
+// computedSchema turns an attribute of a resource into a read only attribute,
+// leaving out everything which is only relevant to arguments, such as
+// validations and defaults.
+func computedSchema(s *schema.Schema) *schema.Schema {
+	c := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Sensitive:   s.Sensitive,
+		Description: s.Description,
+	}
+	switch e := s.Elem.(type) {
+	case *schema.Resource:
+		c.Elem = &schema.Resource{Schema: dataSourceSchemaFromResourceSchema(e.Schema)}
+	case *schema.Schema:
+		c.Elem = &schema.Schema{Type: e.Type}
+	case schema.ValueType:
+		c.Elem = &schema.Schema{Type: e}
+	}
+	return c
+}
@@ -0,0 +1,124 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func TestDataSourceSchemaFromResourceSchema(t *testing.T) {
+	s := dataSourceSchemaFromResourceSchema(map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "Name",
+		},
+		"secret": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"tags": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+					},
+				},
+			},
+		},
+	}, "name")
+
+	if n := s["name"]; !n.Required || n.Computed || n.ValidateFunc == nil || n.Description != "Name" {
+		t.Errorf("expected name to be kept as a required argument, got %#v", n)
+	}
+	if secret := s["secret"]; !secret.Computed || secret.Optional || !secret.Sensitive {
+		t.Errorf("expected secret to be computed and sensitive, got %#v", secret)
+	}
+	settings := s["settings"]
+	if !settings.Computed || settings.Optional || settings.MaxItems != 0 {
+		t.Errorf("expected settings to be computed, got %#v", settings)
+	}
+	nested := settings.Elem.(*schema.Resource).Schema
+	if enabled := nested["enabled"]; !enabled.Computed || enabled.Default != nil {
+		t.Errorf("expected settings.enabled to be computed without default, got %#v", enabled)
+	}
+	if tags := nested["tags"].Elem.(*schema.Schema); tags.Type != schema.TypeString || tags.ValidateFunc != nil {
+		t.Errorf("expected settings.tags to be a set of strings without validation, got %#v", tags)
+	}
+}
+
+// TestDataSourceResourceParity ensures the data sources expose every
+// attribute of the resources managing the same objects.
+func TestDataSourceResourceParity(t *testing.T) {
+	p := Provider()
+
+	// Attributes which only exist in the state of the resource.
+	ignored := map[string][]string{
+		"auth0_client": {
+			"client_secret_rotation_trigger", "rotation_interval",
+			"client_secret_rotated_at", "previous_client_secret",
+		},
+		"auth0_client_grant":    {"adopt_existing", "validate_scopes"},
+		"auth0_resource_server": {"ignore_external_scopes", "signing_secret_rotation_trigger"},
+	}
+
+	for name, ds := range p.DataSourcesMap {
+		r, ok := p.ResourcesMap[name]
+		if !ok {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			rs := map[string]*schema.Schema{}
+			for k, v := range r.Schema {
+				rs[k] = v
+			}
+			for _, k := range ignored[name] {
+				if _, ok := ds.Schema[k]; ok {
+					t.Errorf("expected %s to be left out of the data source", k)
+				}
+				delete(rs, k)
+			}
+			assertSchemaParity(t, "", ds.Schema, rs)
+		})
+	}
+}
+
+func assertSchemaParity(t *testing.T, prefix string, ds, rs map[string]*schema.Schema) {
+	for k, r := range rs {
+		d, ok := ds[k]
+		if !ok {
+			t.Errorf("%s%s is missing from the data source", prefix, k)
+			continue
+		}
+		if d.Type != r.Type {
+			t.Errorf("%s%s is a %s in the data source, but a %s in the resource", prefix, k, d.Type, r.Type)
+		}
+		if !d.Computed && !d.Required {
+			t.Errorf("%s%s must be either computed or a lookup key of the data source", prefix, k)
+		}
+		if d.Computed && (d.ValidateFunc != nil || d.Default != nil || d.DefaultFunc != nil) {
+			t.Errorf("%s%s must not have validations or defaults in the data source", prefix, k)
+		}
+		if re, ok := r.Elem.(*schema.Resource); ok {
+			de, ok := d.Elem.(*schema.Resource)
+			if !ok {
+				t.Errorf("%s%s must be a block in the data source", prefix, k)
+				continue
+			}
+			assertSchemaParity(t, prefix+k+".", de.Schema, re.Schema)
+		}
+	}
+}
@@ -0,0 +1,930 @@
+package auth0
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// clientAddonAttribute maps an attribute of an addon block to the key Auth0
+// uses for it.
+type clientAddonAttribute struct {
+	name   string
+	key    string
+	schema *schema.Schema
+}
+
+type clientAddon struct {
+	description string
+	attributes  []clientAddonAttribute
+}
+
+func addonString(name, key, description string, validate ...schema.SchemaValidateFunc) clientAddonAttribute {
+	s := &schema.Schema{Type: schema.TypeString, Optional: true, Description: description}
+	if len(validate) > 0 {
+		s.ValidateFunc = validation.All(validate...)
+	}
+	return clientAddonAttribute{name, key, s}
+}
+
+func addonSecret(name, key, description string) clientAddonAttribute {
+	a := addonString(name, key, description)
+	a.schema.Sensitive = true
+	return a
+}
+
+func addonInt(name, key, description string, validate ...schema.SchemaValidateFunc) clientAddonAttribute {
+	s := &schema.Schema{Type: schema.TypeInt, Optional: true, Description: description}
+	if len(validate) > 0 {
+		s.ValidateFunc = validation.All(validate...)
+	}
+	return clientAddonAttribute{name, key, s}
+}
+
+func addonBool(name, key, description string) clientAddonAttribute {
+	return clientAddonAttribute{name, key, &schema.Schema{Type: schema.TypeBool, Optional: true, Description: description}}
+}
+
+func addonStringList(name, key, description string) clientAddonAttribute {
+	return clientAddonAttribute{name, key, &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: description,
+	}}
+}
+
+var salesforceAPIAddonAttributes = []clientAddonAttribute{
+	addonString("client_id", "clientid", "Consumer Key assigned by Salesforce to the Connected App"),
+	addonString("principal", "principal", "Name of the property in the user object that maps to a Salesforce username, e.g. `email`"),
+	addonString("community_name", "communityName", "Community name"),
+	addonString("community_url_section", "community_url_section", "Community URL section"),
+}
+
+// clientAddons are the addons of a client, except samlp which has a
+// dedicated schema.
+var clientAddons = map[string]clientAddon{
+	"aws": {"Configuration settings for the AWS addon", []clientAddonAttribute{
+		addonString("principal", "principal", "AWS principal ARN, e.g. `arn:aws:iam::010616021751:saml-provider/idpname`"),
+		addonString("role", "role", "AWS role ARN, e.g. `arn:aws:iam::010616021751:role/foo`"),
+		addonInt("lifetime_in_seconds", "lifetime_in_seconds", "AWS token lifetime in seconds", validation.IntBetween(900, 43200)),
+	}},
+	"azure_blob": {"Configuration settings for the Azure Blob Storage addon", []clientAddonAttribute{
+		addonString("account_name", "accountName", "Your Azure storage account name. Usually first segment in your Azure storage URL, e.g. `https://acme-org.blob.core.windows.net` would be the account name `acme-org`"),
+		addonSecret("storage_access_key", "storageAccessKey", "Access key associated with this storage account"),
+		addonString("container_name", "containerName", "Container to request a token for, e.g. `my-container`"),
+		addonString("blob_name", "blobName", "Entity to request a token for, e.g. `my-blob`. If blank the computed SAS will apply to the entire storage container"),
+		addonInt("expiration", "expiration", "Expiration in minutes for the generated token (default of 5 minutes)", validation.IntAtLeast(0)),
+		addonString("signed_identifier", "signedIdentifier", "Shared access policy identifier defined in your storage account resource"),
+		addonBool("blob_read", "blob_read", "Indicates if the issued token has permission to read the content, properties, metadata and block list. Use the blob as the source of a copy operation"),
+		addonBool("blob_write", "blob_write", "Indicates if the issued token has permission to create or write content, properties, metadata, or block list. Snapshot or lease the blob. Resize the blob (page blob only). Use the blob as the destination of a copy operation within the same account"),
+		addonBool("blob_delete", "blob_delete", "Indicates if the issued token has permission to delete the blob"),
+		addonBool("container_read", "container_read", "Indicates if the issued token has permission to read the content, properties, metadata or block list of any blob in the container. Use any blob in the container as the source of a copy operation"),
+		addonBool("container_write", "container_write", "Indicates that for any blob in the container if the issued token has permission to create or write content, properties, metadata, or block list. Snapshot or lease the blob. Resize the blob (page blob only). Use the blob as the destination of a copy operation within the same account"),
+		addonBool("container_delete", "container_delete", "Indicates if issued token has permission to delete any blob in the container"),
+		addonBool("container_list", "container_list", "Indicates if the issued token has permission to list blobs in the container"),
+	}},
+	"azure_sb": {"Configuration settings for the Azure Service Bus addon", []clientAddonAttribute{
+		addonString("namespace", "namespace", "Your Azure Service Bus namespace. Usually the first segment of your Service Bus URL, e.g. `https://acme-org.servicebus.windows.net` would be `acme-org`"),
+		addonString("sas_key_name", "sasKeyName", "Your shared access policy name defined in your Service Bus entity"),
+		addonSecret("sas_key", "sasKey", "Primary Key associated with your shared access policy"),
+		addonString("entity_path", "entityPath", "Entity you want to request a token for, e.g. `my-queue`"),
+		addonInt("expiration", "expiration", "Optional expiration in minutes for the generated token. Defaults to 5 minutes", validation.IntAtLeast(0)),
+	}},
+	"rms": {"Configuration settings for the Active Directory Rights Management Service addon", []clientAddonAttribute{
+		addonString("url", "url", "URL of your Rights Management Server", validation.IsURLWithHTTPorHTTPS),
+	}},
+	"mscrm": {"Configuration settings for the Microsoft Dynamics CRM addon", []clientAddonAttribute{
+		addonString("url", "url", "Microsoft Dynamics CRM application URL", validation.IsURLWithHTTPorHTTPS),
+	}},
+	"slack": {"Configuration settings for the Slack addon", []clientAddonAttribute{
+		addonString("team", "team", "Slack team name"),
+	}},
+	"sentry": {"Configuration settings for the Sentry addon", []clientAddonAttribute{
+		addonString("org_slug", "org_slug", "Generated slug for your Sentry organization. Found in your Sentry URL, e.g. `https://sentry.acme.com/acme-org/` would be `acme-org`"),
+		addonString("base_url", "base_url", "URL prefix only if running Sentry Community Edition, otherwise leave empty", validation.IsURLWithHTTPorHTTPS),
+	}},
+	"box":       {"Enables the Box addon", nil},
+	"cloudbees": {"Enables the CloudBees addon", nil},
+	"concur":    {"Enables the Concur addon", nil},
+	"dropbox":   {"Enables the Dropbox addon", nil},
+	"wsfed":     {"Enables the WS-Fed (WIF) addon", nil},
+	"echosign": {"Configuration settings for the Adobe EchoSign addon", []clientAddonAttribute{
+		addonString("domain", "domain", "Your custom domain found in your EchoSign URL, e.g. `https://acme-org.echosign.com` would be `acme-org`"),
+	}},
+	"egnyte": {"Configuration settings for the Egnyte addon", []clientAddonAttribute{
+		addonString("domain", "domain", "Your custom domain found in your Egnyte URL, e.g. `https://acme-org.egnyte.com` would be `acme-org`"),
+	}},
+	"firebase": {"Configuration settings for the Google Firebase addon", []clientAddonAttribute{
+		addonSecret("secret", "secret", "Google Firebase Secret. (SDK 2 only)"),
+		addonString("private_key_id", "private_key_id", "Optional ID of the private key to obtain kid header in the issued token (SDK v3+ tokens only)"),
+		addonSecret("private_key", "private_key", "Private Key for signing the token (SDK v3+ tokens only)"),
+		addonString("client_email", "client_email", "ID of the Service Account you have created (shown as `client_email` in the generated JSON file, SDK v3+ tokens only)"),
+		addonInt("lifetime_in_seconds", "lifetime_in_seconds", "Optional expiration in seconds for the generated token. Defaults to 3600 seconds (SDK v3+ tokens only)", validation.IntAtLeast(0)),
+	}},
+	"newrelic": {"Configuration settings for the New Relic addon", []clientAddonAttribute{
+		addonString("account", "account", "Your New Relic Account ID found in your New Relic URL after the `/accounts/` path, e.g. `https://rpm.newrelic.com/accounts/123456/query` would be `123456`"),
+	}},
+	"office365": {"Configuration settings for the Microsoft Office 365 addon", []clientAddonAttribute{
+		addonString("domain", "domain", "Your Office 365 domain name, e.g. `acme-org.com`"),
+		addonString("connection", "connection", "Optional Auth0 database connection for testing Office 365 integration without an Active Directory connection"),
+	}},
+	"salesforce": {"Configuration settings for the Salesforce addon", []clientAddonAttribute{
+		addonString("entity_id", "entity_id", "Arbitrary logical URL that identifies the Salesforce resource, e.g. `https://acme-org.com`", validation.IsURLWithHTTPorHTTPS),
+	}},
+	"salesforce_api":         {"Configuration settings for the Salesforce API addon", salesforceAPIAddonAttributes},
+	"salesforce_sandbox_api": {"Configuration settings for the Salesforce Sandbox API addon", salesforceAPIAddonAttributes},
+	"layer": {"Configuration settings for the Layer addon", []clientAddonAttribute{
+		addonString("provider_id", "providerId", "Provider ID of your Layer account"),
+		addonString("key_id", "keyId", "Authentication Key identifier used to sign the Layer token"),
+		addonSecret("private_key", "privateKey", "Private key for signing the Layer token"),
+		addonString("principal", "principal", "Name of the property used as the unique user ID in Layer. If not specified `user_id` is used"),
+		addonInt("expiration", "expiration", "Optional expiration in minutes for the generated token. Defaults to 5 minutes", validation.IntAtLeast(0)),
+	}},
+	"sap_api": {"Configuration settings for the SAP Cloud Platform API addon", []clientAddonAttribute{
+		addonString("client_id", "clientid", "If activated in the OAuth 2.0 client configuration (SAP) the SAML attribute `client_id` must be set and equal the `client_id` form parameter of the access token request"),
+		addonString("username_attribute", "usernameAttribute", "Name of the property in the user object that maps to a SAP username, e.g. `email`"),
+		addonString("token_endpoint_url", "tokenEndpointUrl", "Your SAP OData server OAuth2 token endpoint URL", validation.IsURLWithHTTPorHTTPS),
+		addonString("scope", "scope", "Requested scope for SAP APIs"),
+		addonSecret("service_password", "servicePassword", "Service account password to use to authenticate API calls to the token endpoint"),
+		addonString("name_identifier_format", "nameIdentifierFormat", "NameID element of the Subject which can be used to express the user's identity. Defaults to `urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified`"),
+	}},
+	"sharepoint": {"Configuration settings for the SharePoint addon", []clientAddonAttribute{
+		addonString("url", "url", "Internal SharePoint application URL", validation.IsURLWithHTTPorHTTPS),
+		addonStringList("external_url", "external_url", "External SharePoint application URLs if exposed to the Internet"),
+	}},
+	"springcm": {"Configuration settings for the SpringCM addon", []clientAddonAttribute{
+		addonString("acs_url", "acsurl", "SpringCM ACS URL, e.g. `https://na11.springcm.com/atlas/sso/SSOEndpoint.ashx`", validation.IsURLWithHTTPorHTTPS),
+	}},
+	"wams": {"Configuration settings for the Windows Azure Mobile Services addon", []clientAddonAttribute{
+		addonSecret("master_key", "masterkey", "Your master key for Windows Azure Mobile Services"),
+	}},
+	"zendesk": {"Configuration settings for the Zendesk addon", []clientAddonAttribute{
+		addonString("account_name", "accountName", "Zendesk account name usually first segment in your Zendesk URL, e.g. `https://acme-org.zendesk.com` would be `acme-org`"),
+	}},
+	"zoom": {"Configuration settings for the Zoom addon", []clientAddonAttribute{
+		addonString("account", "account", "Zoom account name usually first segment of your Zoom URL, e.g. `https://acme-org.zoom.us` would be `acme-org`"),
+	}},
+}
+
+// clientAddonSchema builds the block of an addon.
+func clientAddonSchema(addon clientAddon) *schema.Schema {
+	s := map[string]*schema.Schema{}
+	for _, a := range addon.attributes {
+		s[a.name] = a.schema
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: addon.description,
+		Elem:        &schema.Resource{Schema: s},
+	}
+}
+
+// clientAddonsSchema builds the addons block of a client.
+func clientAddonsSchema() *schema.Schema {
+	s := map[string]*schema.Schema{
+		"samlp": clientAddonSAMLPSchema(),
+	}
+	for name, addon := range clientAddons {
+		s[name] = clientAddonSchema(addon)
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration settings for add-ons for this client",
+		Elem:        &schema.Resource{Schema: s},
+	}
+}
+
+func clientAddonSAMLPSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Optional:    true,
+		Description: "Configuration settings for a SAML add-on",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audience": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Audience of the SAML Assertion. " +
+						"Default will be the Issuer on SAMLRequest",
+				},
+				"recipient": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Recipient of the SAML Assertion (SubjectConfirmationData). " +
+						"Default is AssertionConsumerUrl on SAMLRequest or Callback URL if no SAMLRequest was sent",
+				},
+				"mappings": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     schema.TypeString,
+					Description: "Mappings between the Auth0 user profile property name (" +
+						"`name`) and the output attributes on the SAML attribute in the assertion (`value`)",
+				},
+				"create_upn_claim": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Indicates whether or not a UPN claim should be created",
+				},
+				"passthrough_claims_with_no_mapping": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+					Description: "Indicates whether or not to passthrough claims that are not" +
+						" mapped to the common profile in the output assertion",
+				},
+				"map_unknown_claims_as_is": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+					Description: "Indicates whether or not to add a prefix of `http://schema." +
+						"auth0.com` to any claims that are not mapped to the common profile when passed through in the output assertion",
+				},
+				"map_identities": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+					Description: "Indicates whether or not to add additional identity information" +
+						" in the token, such as the provider used and the `access_token`, if available",
+				},
+				"signature_algorithm": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "rsa-sha1",
+					Description: "Algorithm used to sign the SAML Assertion or response. " +
+						"Options include `rsa-sha1` (default) and `rsa-sha256`",
+					ValidateFunc: validation.StringInSlice([]string{"rsa-sha1", "rsa-sha256"}, false),
+				},
+				"digest_algorithm": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "sha1",
+					Description: "Algorithm used to calculate the digest of the SAML Assertion or" +
+						" response. Options include `sha1` (default) and `sha256`",
+					ValidateFunc: validation.StringInSlice([]string{"sha1", "sha256"}, false),
+				},
+				"destination": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Destination of the SAML Response. If not specified, " +
+						"it will be `AssertionConsumerUrl` of SAMLRequest or `CallbackURL` if" +
+						" there was no SAMLRequest",
+				},
+				"lifetime_in_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     3600,
+					Description: "Number of seconds during which the token is valid",
+				},
+				"sign_response": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Indicates whether or not the SAML Response should be signed instead" +
+						" of the SAML Assertion",
+				},
+				"name_identifier_format": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+					Description: "Format of the name identifier",
+				},
+				"name_identifier_probes": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Optional: true,
+					Description: "Attributes that can be used for Subject/NameID. " +
+						"Auth0 will try each of the attributes of this array in order and use the" +
+						" first value it finds",
+				},
+				"authn_context_class_ref": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Class reference of the authentication context",
+				},
+				"typed_attributes": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"include_attribute_name_format": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+					Description: "Indicates whether or not we should infer the NameFormat based" +
+						" on the attribute name. If set to false, the attribute NameFormat is not set in the assertion",
+				},
+				"logout": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configuration settings for logout",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"callback": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Description: "Service provider's Single Logout Service URL, " +
+									"to which Auth0 will send logout requests and responses",
+							},
+							"slo_enabled": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Description: "Indicates whether or not Auth0 should notify" +
+									" service providers of session termination",
+							},
+						},
+					},
+				},
+				"binding": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Protocol binding used for SAML logout responses",
+				},
+				"signing_cert": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Optionally indicates the public key certificate used to validate " +
+						"SAML requests. If set, SAML requests will be required to be signed." +
+						" A sample value would be `-----BEGIN PUBLIC KEY-----...-----END PUBLIC KEY-----`",
+				},
+			},
+		},
+	}
+}
+
+func expandClientAddon(d ResourceData, addon clientAddon) MapData {
+	m := make(MapData)
+	for _, a := range addon.attributes {
+		switch a.schema.Type {
+		case schema.TypeString:
+			_ = m.Set(a.key, String(d, a.name))
+		case schema.TypeInt:
+			_ = m.Set(a.key, Int(d, a.name))
+		case schema.TypeBool:
+			_ = m.Set(a.key, Bool(d, a.name))
+		case schema.TypeList:
+			_ = m.Set(a.key, Slice(d, a.name))
+		}
+	}
+	return m
+}
+
+func flattenClientAddon(data map[string]interface{}, addon clientAddon) map[string]interface{} {
+	m := map[string]interface{}{}
+	for _, a := range addon.attributes {
+		v, ok := data[a.key]
+		if !ok {
+			continue
+		}
+		if f, ok := v.(float64); ok && a.schema.Type == schema.TypeInt {
+			v = int(f)
+		}
+		m[a.name] = v
+	}
+	return m
+}
+
+// clientSchemaV0 is the schema of the client before the addons were typed
+// blocks. It is frozen, only the types matter to decode the state.
+func clientSchemaV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"addons": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"azure_blob": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"azure_sb": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"box": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"cloudbees": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"concur": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"dropbox": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"echosign": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"egnyte": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"firebase": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"layer": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"mscrm": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"newrelic": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"office365": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"rms": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"salesforce": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"salesforce_api": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"salesforce_sandbox_api": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"samlp": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audience": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"authn_context_class_ref": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"binding": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"create_upn_claim": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"destination": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"digest_algorithm": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"include_attribute_name_format": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"lifetime_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"logout": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"callback": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"slo_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"map_identities": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"map_unknown_claims_as_is": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"mappings": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+									"name_identifier_format": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"name_identifier_probes": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"passthrough_claims_with_no_mapping": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"recipient": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sign_response": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"signature_algorithm": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"signing_cert": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"typed_attributes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"sap_api": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"sentry": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"sharepoint": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"slack": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"springcm": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"wams": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"wsfed": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"zendesk": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+						"zoom": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"allowed_clients": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_logout_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_origins": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"app_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"callbacks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret_rotation_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"cross_origin_auth": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"cross_origin_loc": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"custom_login_page": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"custom_login_page_on": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encryption_key": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"form_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"grant_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"initiate_login_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"is_first_party": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_token_endpoint_ip_header_trusted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"jwt_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alg": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"lifetime_in_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"scopes": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"secret_encoded": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"logo_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mobile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"android": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_package_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sha256_cert_fingerprints": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"ios": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_bundle_identifier": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"team_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"oidc_conformant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"organization_require_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"organization_usage": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"refresh_token": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"idle_token_lifetime": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"infinite_idle_token_lifetime": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"infinite_token_lifetime": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"leeway": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"rotation_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"token_lifetime": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"sso": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"sso_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"token_endpoint_auth_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"web_origins": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// clientSchemaUpgradeV0 turns the addons held in maps of strings into typed
+// blocks. The keys of the maps were sent to Auth0 as is, so both the Auth0 keys
+// and the attribute names are recognized. Unknown keys are dropped.
+func clientSchemaUpgradeV0(ctx context.Context, state map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	o, ok := state["addons"]
+	if !ok {
+		return state, nil
+	}
+
+	l, ok := o.([]interface{})
+	if !ok || len(l) == 0 {
+		return state, nil
+	}
+	m, ok := l[0].(map[string]interface{})
+	if !ok {
+		return state, nil
+	}
+
+	names := make([]string, 0, len(clientAddons))
+	for name := range clientAddons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values, _ := m[name].(map[string]interface{})
+		if len(values) == 0 {
+			m[name] = []interface{}{}
+			continue
+		}
+
+		block := map[string]interface{}{}
+		for _, a := range clientAddons[name].attributes {
+			v, ok := values[a.name]
+			if !ok {
+				v, ok = values[a.key]
+			}
+			s, isString := v.(string)
+			if !ok || !isString {
+				continue
+			}
+			switch a.schema.Type {
+			case schema.TypeString:
+				block[a.name] = s
+			case schema.TypeInt:
+				if i, err := strconv.Atoi(s); err == nil {
+					block[a.name] = i
+				}
+			case schema.TypeBool:
+				if b, err := strconv.ParseBool(s); err == nil {
+					block[a.name] = b
+				}
+			case schema.TypeList:
+				block[a.name] = []interface{}{s}
+			}
+		}
+		m[name] = []interface{}{block}
+
+		log.Printf("[DEBUG] Schema upgrade: addons.%s has been migrated to a block", name)
+	}
+
+	state["addons"] = []interface{}{m}
+	return state, nil
+}
@@ -1,13 +1,152 @@
 package auth0
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"log"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/management"
 )
 
+// Strategies which the SDK doesn't have a constant for.
+const (
+	connectionStrategyADFS          = "adfs"
+	connectionStrategyOffice365     = "office365"
+	connectionStrategyTwitter       = "twitter"
+	connectionStrategyPingFederate  = "pingfederate"
+	connectionStrategyOkta          = "okta"
+	connectionStrategyDropbox       = "dropbox"
+	connectionStrategyBitbucket     = "bitbucket"
+	connectionStrategyPayPal        = "paypal"
+	connectionStrategyPayPalSandbox = "paypal-sandbox"
+)
+
+// connectionOptionsTwitter holds the options of the twitter strategy, which
+// the SDK doesn't type.
+type connectionOptionsTwitter struct {
+	ClientID     *string `json:"client_id,omitempty"`
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+}
+
+// connectionOptionsPingFederate holds the options of the pingfederate
+// strategy, which the SDK doesn't type.
+type connectionOptionsPingFederate struct {
+	SigningCert         *string       `json:"signingCert,omitempty"`
+	PingFederateBaseURL *string       `json:"pingFederateBaseUrl,omitempty"`
+	TenantDomain        *string       `json:"tenant_domain,omitempty"`
+	DomainAliases       []interface{} `json:"domain_aliases,omitempty"`
+	LogoURL             *string       `json:"icon_url,omitempty"`
+	SignSAMLRequest     *bool         `json:"signSAMLRequest,omitempty"`
+	SignatureAlgorithm  *string       `json:"signatureAlgorithm,omitempty"`
+	DigestAlgorithm     *string       `json:"digestAlgorithm,omitempty"`
+
+	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+}
+
+// connectionOptionsOkta holds the options of the okta strategy, which the SDK
+// doesn't type.
+type connectionOptionsOkta struct {
+	ClientID      *string       `json:"client_id,omitempty"`
+	ClientSecret  *string       `json:"client_secret,omitempty"`
+	Domain        *string       `json:"domain,omitempty"`
+	DomainAliases []interface{} `json:"domain_aliases,omitempty"`
+	LogoURL       *string       `json:"icon_url,omitempty"`
+
+	Issuer                *string `json:"issuer,omitempty"`
+	JWKSURI               *string `json:"jwks_uri,omitempty"`
+	TokenEndpoint         *string `json:"token_endpoint,omitempty"`
+	UserInfoEndpoint      *string `json:"userinfo_endpoint,omitempty"`
+	AuthorizationEndpoint *string `json:"authorization_endpoint,omitempty"`
+	Scope                 *string `json:"scope,omitempty"`
+
+	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+}
+
+// connectionOptionsSocial holds the options of the dropbox, bitbucket and
+// paypal strategies, which the SDK doesn't type.
+type connectionOptionsSocial struct {
+	ClientID     *string `json:"client_id,omitempty"`
+	ClientSecret *string `json:"client_secret,omitempty"`
+	Scope        *string `json:"scope,omitempty"`
+
+	SetUserAttributes  *string   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs *[]string `json:"non_persistent_attrs,omitempty"`
+}
+
+func (o *connectionOptionsSocial) Scopes() []string {
+	return strings.Fields(auth0.StringValue(o.Scope))
+}
+
+func (o *connectionOptionsSocial) SetScopes(enable bool, scopes ...string) {
+	enabled := make(map[string]bool)
+	for _, scope := range o.Scopes() {
+		enabled[scope] = true
+	}
+	for _, scope := range scopes {
+		enabled[scope] = enable
+	}
+	var s []string
+	for scope, ok := range enabled {
+		if ok {
+			s = append(s, scope)
+		}
+	}
+	sort.Strings(s)
+	o.Scope = auth0.String(strings.Join(s, " "))
+}
+
+// decodeConnectionOptions converts the untyped options the SDK returns for the
+// strategies it doesn't know about into their typed counterpart. Options of
+// strategies without a typed counterpart are returned as is.
+func decodeConnectionOptions(strategy string, options map[string]interface{}) interface{} {
+
+	var v interface{}
+
+	switch strategy {
+	case connectionStrategyADFS:
+		v = &management.ConnectionOptionsADFS{}
+	case connectionStrategyOffice365:
+		v = &management.ConnectionOptionsAzureAD{}
+	case connectionStrategyTwitter:
+		v = &connectionOptionsTwitter{}
+	case connectionStrategyPingFederate:
+		v = &connectionOptionsPingFederate{}
+	case connectionStrategyOkta:
+		v = &connectionOptionsOkta{}
+	case connectionStrategyDropbox,
+		connectionStrategyBitbucket,
+		connectionStrategyPayPal,
+		connectionStrategyPayPalSandbox:
+		v = &connectionOptionsSocial{}
+	default:
+		return options
+	}
+
+	b, err := json.Marshal(options)
+	if err != nil {
+		return options
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return options
+	}
+	return v
+}
+
 func flattenConnectionOptions(d ResourceData, options interface{}) []interface{} {
 
+	if o, ok := options.(map[string]interface{}); ok {
+		options = decodeConnectionOptions(d.Get("strategy").(string), o)
+	}
+
 	var m interface{}
 
 	switch o := options.(type) {
@@ -41,11 +180,60 @@ func flattenConnectionOptions(d ResourceData, options interface{}) []interface{}
 		m = flattenConnectionOptionsAzureAD(o)
 	case *management.ConnectionOptionsSAML:
 		m = flattenConnectionOptionsSAML(o)
+	case *management.ConnectionOptionsADFS:
+		m = flattenConnectionOptionsADFS(o)
+	case *management.ConnectionOptionsGoogleApps:
+		m = flattenConnectionOptionsGoogleApps(o)
+	case *connectionOptionsTwitter:
+		m = flattenConnectionOptionsTwitter(o)
+	case *connectionOptionsPingFederate:
+		m = flattenConnectionOptionsPingFederate(o)
+	case *connectionOptionsOkta:
+		m = flattenConnectionOptionsOkta(o)
+	case *connectionOptionsSocial:
+		m = flattenConnectionOptionsSocial(o)
+	}
+
+	if m == nil {
+		return nil
 	}
 
 	return []interface{}{m}
 }
 
+// flattenConnectionOptionsJSON encodes options as JSON. Once options_json is
+// set, only the top level keys it holds are kept, so that defaults and
+// secrets returned by the API don't show up as drift.
+func flattenConnectionOptionsJSON(d ResourceData, options interface{}) (string, error) {
+	if options == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(options)
+	if err != nil {
+		return "", err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return "", err
+	}
+
+	known, err := JSON(d, "options_json")
+	if err != nil {
+		return "", err
+	}
+	if known != nil {
+		for k := range m {
+			if _, ok := known[k]; !ok {
+				delete(m, k)
+			}
+		}
+	}
+
+	return structure.FlattenJsonToString(m)
+}
+
 func flattenConnectionOptionsGitHub(o *management.ConnectionOptionsGitHub) interface{} {
 	return map[string]interface{}{
 		"client_id":                o.GetClientID(),
@@ -80,13 +268,30 @@ func flattenConnectionOptionsAuth0(d ResourceData, o *management.ConnectionOptio
 		"import_mode":                    o.GetImportMode(),
 		"disable_signup":                 o.GetDisableSignup(),
 		"requires_username":              o.GetRequiresUsername(),
-		"custom_scripts":                 o.CustomScripts,
+		"custom_scripts":                 flattenConnectionCustomScripts(d, o.CustomScripts),
+		"custom_script_files":            Map(d, "options.0.custom_script_files"), // only the hashes are read back
 		"mfa":                            flattenMap(o.MFA),
 		"configuration":                  Map(d, "options.0.configuration"), // does not get read back
 		"non_persistent_attrs":           o.GetNonPersistentAttrs(),
 	}
 }
 
+// flattenConnectionCustomScripts leaves out the scripts loaded from files, which
+// are tracked by custom_script_hashes instead.
+func flattenConnectionCustomScripts(d ResourceData, scripts map[string]interface{}) map[string]interface{} {
+	files := Map(d, "options.0.custom_script_files")
+	if len(files) == 0 {
+		return scripts
+	}
+	m := make(map[string]interface{})
+	for name, script := range scripts {
+		if _, ok := files[name]; !ok {
+			m[name] = script
+		}
+	}
+	return m
+}
+
 // flattenMap fixes the issue "source data must be an array or slice, got map"
 //
 func flattenMap(input map[string]interface{}) interface{} {
@@ -253,7 +458,7 @@ func flattenConnectionOptionsAzureAD(o *management.ConnectionOptionsAzureAD) int
 		"icon_url":                               o.GetLogoURL(),
 		"identity_api":                           o.GetIdentityAPI(),
 		"waad_protocol":                          o.GetWAADProtocol(),
-		"waad_common_endpoint":                   o.GetUseCommonEndpoint(),
+		"waad_common_endpoint":                   o.GetWAADCommonEndpoint() || o.GetUseCommonEndpoint(),
 		"use_wsfed":                              o.GetUseWSFederation(),
 		"api_enable_users":                       o.GetEnableUsersAPI(),
 		"max_groups_to_retrieve":                 o.GetMaxGroupsToRetrieve(),
@@ -264,6 +469,85 @@ func flattenConnectionOptionsAzureAD(o *management.ConnectionOptionsAzureAD) int
 	}
 }
 
+func flattenConnectionOptionsADFS(o *management.ConnectionOptionsADFS) interface{} {
+	return map[string]interface{}{
+		"tenant_domain":            o.GetTenantDomain(),
+		"domain_aliases":           o.DomainAliases,
+		"icon_url":                 o.GetLogoURL(),
+		"adfs_server":              o.GetADFSServer(),
+		"api_enable_users":         o.GetEnableUsersAPI(),
+		"set_user_root_attributes": o.GetSetUserAttributes(),
+		"non_persistent_attrs":     o.GetNonPersistentAttrs(),
+	}
+}
+
+func flattenConnectionOptionsGoogleApps(o *management.ConnectionOptionsGoogleApps) interface{} {
+	return map[string]interface{}{
+		"client_id":                o.GetClientID(),
+		"client_secret":            o.GetClientSecret(),
+		"domain":                   o.GetDomain(),
+		"tenant_domain":            o.GetTenantDomain(),
+		"domain_aliases":           o.DomainAliases,
+		"icon_url":                 o.GetLogoURL(),
+		"api_enable_users":         o.GetEnableUsersAPI(),
+		"scopes":                   o.Scopes(),
+		"set_user_root_attributes": o.GetSetUserAttributes(),
+		"non_persistent_attrs":     o.GetNonPersistentAttrs(),
+	}
+}
+
+func flattenConnectionOptionsTwitter(o *connectionOptionsTwitter) interface{} {
+	return map[string]interface{}{
+		"client_id":                auth0.StringValue(o.ClientID),
+		"client_secret":            auth0.StringValue(o.ClientSecret),
+		"set_user_root_attributes": auth0.StringValue(o.SetUserAttributes),
+		"non_persistent_attrs":     castFromListOfStrings(o.NonPersistentAttrs),
+	}
+}
+
+func flattenConnectionOptionsPingFederate(o *connectionOptionsPingFederate) interface{} {
+	return map[string]interface{}{
+		"signing_cert":             auth0.StringValue(o.SigningCert),
+		"ping_federate_base_url":   auth0.StringValue(o.PingFederateBaseURL),
+		"tenant_domain":            auth0.StringValue(o.TenantDomain),
+		"domain_aliases":           o.DomainAliases,
+		"icon_url":                 auth0.StringValue(o.LogoURL),
+		"sign_saml_request":        auth0.BoolValue(o.SignSAMLRequest),
+		"signature_algorithm":      auth0.StringValue(o.SignatureAlgorithm),
+		"digest_algorithm":         auth0.StringValue(o.DigestAlgorithm),
+		"set_user_root_attributes": auth0.StringValue(o.SetUserAttributes),
+		"non_persistent_attrs":     castFromListOfStrings(o.NonPersistentAttrs),
+	}
+}
+
+func flattenConnectionOptionsOkta(o *connectionOptionsOkta) interface{} {
+	return map[string]interface{}{
+		"client_id":                auth0.StringValue(o.ClientID),
+		"client_secret":            auth0.StringValue(o.ClientSecret),
+		"domain":                   auth0.StringValue(o.Domain),
+		"domain_aliases":           o.DomainAliases,
+		"icon_url":                 auth0.StringValue(o.LogoURL),
+		"issuer":                   auth0.StringValue(o.Issuer),
+		"jwks_uri":                 auth0.StringValue(o.JWKSURI),
+		"token_endpoint":           auth0.StringValue(o.TokenEndpoint),
+		"userinfo_endpoint":        auth0.StringValue(o.UserInfoEndpoint),
+		"authorization_endpoint":   auth0.StringValue(o.AuthorizationEndpoint),
+		"scopes":                   strings.Fields(auth0.StringValue(o.Scope)),
+		"set_user_root_attributes": auth0.StringValue(o.SetUserAttributes),
+		"non_persistent_attrs":     castFromListOfStrings(o.NonPersistentAttrs),
+	}
+}
+
+func flattenConnectionOptionsSocial(o *connectionOptionsSocial) interface{} {
+	return map[string]interface{}{
+		"client_id":                auth0.StringValue(o.ClientID),
+		"client_secret":            auth0.StringValue(o.ClientSecret),
+		"scopes":                   o.Scopes(),
+		"set_user_root_attributes": auth0.StringValue(o.SetUserAttributes),
+		"non_persistent_attrs":     castFromListOfStrings(o.NonPersistentAttrs),
+	}
+}
+
 func flattenConnectionOptionsSAML(o *management.ConnectionOptionsSAML) interface{} {
 	return map[string]interface{}{
 		"signing_cert":     o.GetSigningCert(),
@@ -292,7 +576,7 @@ func flattenConnectionOptionsSAML(o *management.ConnectionOptionsSAML) interface
 	}
 }
 
-func expandConnection(d ResourceData) *management.Connection {
+func expandConnection(d ResourceData) (*management.Connection, error) {
 
 	c := &management.Connection{
 		Name:               String(d, "name", IsNewResource()),
@@ -305,48 +589,218 @@ func expandConnection(d ResourceData) *management.Connection {
 
 	s := d.Get("strategy").(string)
 
+	var err error
 	List(d, "options").Elem(func(d ResourceData) {
-		switch s {
-		case management.ConnectionStrategyAuth0:
-			c.Options = expandConnectionOptionsAuth0(d)
-		case management.ConnectionStrategyGoogleOAuth2:
-			c.Options = expandConnectionOptionsGoogleOAuth2(d)
-		case management.ConnectionStrategyOAuth2:
-			c.Options = expandConnectionOptionsOAuth2(d)
-		case management.ConnectionStrategyFacebook:
-			c.Options = expandConnectionOptionsFacebook(d)
-		case management.ConnectionStrategyApple:
-			c.Options = expandConnectionOptionsApple(d)
-		case management.ConnectionStrategyLinkedin:
-			c.Options = expandConnectionOptionsLinkedin(d)
-		case management.ConnectionStrategyGitHub:
-			c.Options = expandConnectionOptionsGitHub(d)
-		case management.ConnectionStrategyWindowsLive:
-			c.Options = expandConnectionOptionsWindowsLive(d)
-		case management.ConnectionStrategySalesforce,
-			management.ConnectionStrategySalesforceCommunity,
-			management.ConnectionStrategySalesforceSandbox:
-			c.Options = expandConnectionOptionsSalesforce(d)
-		case management.ConnectionStrategySMS:
-			c.Options = expandConnectionOptionsSMS(d)
-		case management.ConnectionStrategyOIDC:
-			c.Options = expandConnectionOptionsOIDC(d)
-		case management.ConnectionStrategyAD:
-			c.Options = expandConnectionOptionsAD(d)
-		case management.ConnectionStrategyAzureAD:
-			c.Options = expandConnectionOptionsAzureAD(d)
-		case management.ConnectionStrategyEmail:
-			c.Options = expandConnectionOptionsEmail(d)
-		case management.ConnectionStrategySAML:
-			c.Options = expandConnectionOptionsSAML(d)
-		default:
+		c.Options, err = expandConnectionOptions(s, d)
+		if err == nil && c.Options == nil {
 			log.Printf("[WARN]: Unsupported connection strategy %s", s)
-			log.Printf("[WARN]: Raise an issue with the auth0 provider in order to support it:")
-			log.Printf("[WARN]: 	https://github.com/alekc/terraform-provider-auth0/issues/new")
+			log.Printf("[WARN]: Use options_json to configure its options instead")
 		}
 	})
 
-	return c
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := JSON(d, "options_json")
+	if err != nil {
+		return nil, err
+	}
+	if options != nil {
+		c.Options = options
+	}
+
+	return c, nil
+}
+
+// expandConnectionOptions expands the options block of the given strategy. It
+// returns nil for the strategies without typed options.
+func expandConnectionOptions(strategy string, d ResourceData) (interface{}, error) {
+	switch strategy {
+	case management.ConnectionStrategyAuth0:
+		return expandConnectionOptionsAuth0(d)
+	case management.ConnectionStrategyGoogleOAuth2:
+		return expandConnectionOptionsGoogleOAuth2(d), nil
+	case management.ConnectionStrategyOAuth2:
+		return expandConnectionOptionsOAuth2(d), nil
+	case management.ConnectionStrategyFacebook:
+		return expandConnectionOptionsFacebook(d), nil
+	case management.ConnectionStrategyApple:
+		return expandConnectionOptionsApple(d), nil
+	case management.ConnectionStrategyLinkedin:
+		return expandConnectionOptionsLinkedin(d), nil
+	case management.ConnectionStrategyGitHub:
+		return expandConnectionOptionsGitHub(d), nil
+	case management.ConnectionStrategyWindowsLive:
+		return expandConnectionOptionsWindowsLive(d), nil
+	case management.ConnectionStrategySalesforce,
+		management.ConnectionStrategySalesforceCommunity,
+		management.ConnectionStrategySalesforceSandbox:
+		return expandConnectionOptionsSalesforce(d), nil
+	case management.ConnectionStrategySMS:
+		return expandConnectionOptionsSMS(d), nil
+	case management.ConnectionStrategyOIDC:
+		return expandConnectionOptionsOIDC(d), nil
+	case management.ConnectionStrategyAD:
+		return expandConnectionOptionsAD(d), nil
+	case management.ConnectionStrategyAzureAD,
+		connectionStrategyOffice365:
+		return expandConnectionOptionsAzureAD(d), nil
+	case management.ConnectionStrategyEmail:
+		return expandConnectionOptionsEmail(d), nil
+	case management.ConnectionStrategySAML:
+		return expandConnectionOptionsSAML(d), nil
+	case management.ConnectionStrategyGoogleApps:
+		return expandConnectionOptionsGoogleApps(d), nil
+	case connectionStrategyADFS:
+		return expandConnectionOptionsADFS(d), nil
+	case connectionStrategyTwitter:
+		return expandConnectionOptionsTwitter(d), nil
+	case connectionStrategyPingFederate:
+		return expandConnectionOptionsPingFederate(d), nil
+	case connectionStrategyOkta:
+		return expandConnectionOptionsOkta(d), nil
+	case connectionStrategyDropbox,
+		connectionStrategyBitbucket,
+		connectionStrategyPayPal,
+		connectionStrategyPayPalSandbox:
+		return expandConnectionOptionsSocial(d), nil
+	}
+	return nil, nil
+}
+
+// keyRecorder is a ResourceData holding no values, which records the keys
+// being accessed.
+type keyRecorder map[string]bool
+
+func (r keyRecorder) IsNewResource() bool { return false }
+
+func (r keyRecorder) HasChange(key string) bool {
+	r[key] = true
+	return false
+}
+
+func (r keyRecorder) GetChange(key string) (interface{}, interface{}) {
+	r[key] = true
+	return nil, nil
+}
+
+func (r keyRecorder) Get(key string) interface{} {
+	r[key] = true
+	return nil
+}
+
+func (r keyRecorder) GetOk(key string) (interface{}, bool) {
+	r[key] = true
+	return nil, false
+}
+
+func (r keyRecorder) GetOkExists(key string) (interface{}, bool) {
+	r[key] = true
+	return nil, false
+}
+
+func (r keyRecorder) Set(key string, value interface{}) error { return nil }
+
+// connectionOptionKeys returns the keys of the options block which
+// expandConnectionOptions reads for the given strategy.
+func connectionOptionKeys(strategy string) map[string]bool {
+	keys := keyRecorder{}
+	_, _ = expandConnectionOptions(strategy, keys)
+	return keys
+}
+
+func expandConnectionOptionsADFS(d ResourceData) *management.ConnectionOptionsADFS {
+	return &management.ConnectionOptionsADFS{
+		TenantDomain:       String(d, "tenant_domain"),
+		DomainAliases:      Set(d, "domain_aliases").List(),
+		LogoURL:            String(d, "icon_url"),
+		ADFSServer:         String(d, "adfs_server"),
+		EnableUsersAPI:     Bool(d, "api_enable_users"),
+		SetUserAttributes:  String(d, "set_user_root_attributes"),
+		NonPersistentAttrs: castToListOfStrings(Set(d, "non_persistent_attrs").List()),
+	}
+}
+
+func expandConnectionOptionsGoogleApps(d ResourceData) *management.ConnectionOptionsGoogleApps {
+
+	o := &management.ConnectionOptionsGoogleApps{
+		ClientID:           String(d, "client_id"),
+		ClientSecret:       String(d, "client_secret"),
+		Domain:             String(d, "domain"),
+		TenantDomain:       String(d, "tenant_domain"),
+		DomainAliases:      Set(d, "domain_aliases").List(),
+		LogoURL:            String(d, "icon_url"),
+		EnableUsersAPI:     Bool(d, "api_enable_users"),
+		SetUserAttributes:  String(d, "set_user_root_attributes"),
+		NonPersistentAttrs: castToListOfStrings(Set(d, "non_persistent_attrs").List()),
+	}
+
+	expandConnectionOptionsScopes(d, o)
+
+	return o
+}
+
+func expandConnectionOptionsTwitter(d ResourceData) *connectionOptionsTwitter {
+	return &connectionOptionsTwitter{
+		ClientID:           String(d, "client_id"),
+		ClientSecret:       String(d, "client_secret"),
+		SetUserAttributes:  String(d, "set_user_root_attributes"),
+		NonPersistentAttrs: castToListOfStrings(Set(d, "non_persistent_attrs").List()),
+	}
+}
+
+func expandConnectionOptionsPingFederate(d ResourceData) *connectionOptionsPingFederate {
+	return &connectionOptionsPingFederate{
+		SigningCert:         String(d, "signing_cert"),
+		PingFederateBaseURL: String(d, "ping_federate_base_url"),
+		TenantDomain:        String(d, "tenant_domain"),
+		DomainAliases:       Set(d, "domain_aliases").List(),
+		LogoURL:             String(d, "icon_url"),
+		SignSAMLRequest:     Bool(d, "sign_saml_request"),
+		SignatureAlgorithm:  String(d, "signature_algorithm"),
+		DigestAlgorithm:     String(d, "digest_algorithm"),
+		SetUserAttributes:   String(d, "set_user_root_attributes"),
+		NonPersistentAttrs:  castToListOfStrings(Set(d, "non_persistent_attrs").List()),
+	}
+}
+
+func expandConnectionOptionsSocial(d ResourceData) *connectionOptionsSocial {
+
+	o := &connectionOptionsSocial{
+		ClientID:           String(d, "client_id"),
+		ClientSecret:       String(d, "client_secret"),
+		SetUserAttributes:  String(d, "set_user_root_attributes"),
+		NonPersistentAttrs: castToListOfStrings(Set(d, "non_persistent_attrs").List()),
+	}
+
+	expandConnectionOptionsScopes(d, o)
+
+	return o
+}
+
+func expandConnectionOptionsOkta(d ResourceData) *connectionOptionsOkta {
+
+	o := &connectionOptionsOkta{
+		ClientID:              String(d, "client_id"),
+		ClientSecret:          String(d, "client_secret"),
+		Domain:                String(d, "domain"),
+		DomainAliases:         Set(d, "domain_aliases").List(),
+		LogoURL:               String(d, "icon_url"),
+		Issuer:                String(d, "issuer"),
+		JWKSURI:               String(d, "jwks_uri"),
+		TokenEndpoint:         String(d, "token_endpoint"),
+		UserInfoEndpoint:      String(d, "userinfo_endpoint"),
+		AuthorizationEndpoint: String(d, "authorization_endpoint"),
+		SetUserAttributes:     String(d, "set_user_root_attributes"),
+		NonPersistentAttrs:    castToListOfStrings(Set(d, "non_persistent_attrs").List()),
+	}
+
+	if scopes := castToListOfStrings(Set(d, "scopes").List()); scopes != nil {
+		o.Scope = auth0.String(strings.Join(*scopes, " "))
+	}
+
+	return o
 }
 
 func expandConnectionOptionsGitHub(d ResourceData) *management.ConnectionOptionsGitHub {
@@ -363,7 +817,7 @@ func expandConnectionOptionsGitHub(d ResourceData) *management.ConnectionOptions
 	return o
 }
 
-func expandConnectionOptionsAuth0(d ResourceData) *management.ConnectionOptions {
+func expandConnectionOptionsAuth0(d ResourceData) (*management.ConnectionOptions, error) {
 
 	o := &management.ConnectionOptions{
 		PasswordPolicy:     String(d, "password_policy"),
@@ -416,7 +870,19 @@ func expandConnectionOptionsAuth0(d ResourceData) *management.ConnectionOptions
 	o.CustomScripts = Map(d, "custom_scripts")
 	o.Configuration = Map(d, "configuration")
 
-	return o
+	files := Map(d, "custom_script_files")
+	if len(files) > 0 && o.CustomScripts == nil {
+		o.CustomScripts = make(map[string]interface{})
+	}
+	for name, path := range files {
+		script, err := ioutil.ReadFile(path.(string))
+		if err != nil {
+			return nil, err
+		}
+		o.CustomScripts[name] = string(script)
+	}
+
+	return o, nil
 }
 
 func expandConnectionOptionsGoogleOAuth2(d ResourceData) *management.ConnectionOptionsGoogleOAuth2 {
@@ -615,6 +1081,7 @@ func expandConnectionOptionsAzureAD(d ResourceData) *management.ConnectionOption
 		UseWSFederation:     Bool(d, "use_wsfed"),
 		WAADProtocol:        String(d, "waad_protocol"),
 		UseCommonEndpoint:   Bool(d, "waad_common_endpoint"),
+		WAADCommonEndpoint:  Bool(d, "waad_common_endpoint"),
 		EnableUsersAPI:      Bool(d, "api_enable_users"),
 		LogoURL:             String(d, "icon_url"),
 		IdentityAPI:         String(d, "identity_api"),
@@ -711,3 +1178,10 @@ func castToListOfStrings(interfaces []interface{}) *[]string {
 	}
 	return &strings
 }
+
+func castFromListOfStrings(strings *[]string) []string {
+	if strings == nil {
+		return nil
+	}
+	return *strings
+}
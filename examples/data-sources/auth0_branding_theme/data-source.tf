@@ -0,0 +1 @@
+data "auth0_branding_theme" "default" {}
@@ -0,0 +1,4 @@
+data "auth0_client_grant" "my_client_grant" {
+  client_id = "AaiyAPdpYdesoKnqjj8HJqRn4T5titww"
+  audience  = "https://api.example.com"
+}
@@ -0,0 +1,11 @@
+data "auth0_email_template_preview" "welcome" {
+  template = "welcome_email"
+  context = jsonencode({
+    application = { name = "My App" }
+    user        = { name = "John Doe", email = "john.doe@example.com" }
+  })
+}
+
+output "welcome_email" {
+  value = data.auth0_email_template_preview.welcome.rendered_body
+}
@@ -0,0 +1,17 @@
+data "auth0_users_export" "compliance" {
+  connection_id = "con_0000000000000001"
+  format        = "csv"
+  output_path   = "${path.module}/users.csv.gz"
+
+  fields {
+    name = "email"
+  }
+  fields {
+    name      = "user_id"
+    export_as = "id"
+  }
+}
+
+output "exported_users" {
+  value = data.auth0_users_export.compliance.rows
+}
@@ -0,0 +1,37 @@
+resource "auth0_branding_theme" "my_theme" {
+  display_name = "My theme"
+
+  borders {
+    buttons_style        = "pill"
+    widget_corner_radius = 10
+  }
+
+  colors {
+    primary_button    = "#0059d6"
+    widget_background = "#ffffff"
+  }
+
+  fonts {
+    reference_text_size = 16
+    body_text {}
+    buttons_text {}
+    input_labels {}
+    links {
+      bold = true
+    }
+    subtitle {}
+    title {
+      size = 125
+    }
+  }
+
+  page_background {
+    background_color = "#000000"
+    page_layout      = "center"
+  }
+
+  widget {
+    logo_position = "center"
+    logo_url      = "https://mycompany.org/v1/logo.png"
+  }
+}
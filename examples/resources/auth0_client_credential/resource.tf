@@ -0,0 +1,16 @@
+resource "auth0_client" "my_service" {
+  name     = "My Service"
+  app_type = "non_interactive"
+}
+
+resource "auth0_client_credential" "my_service" {
+  client_id  = auth0_client.my_service.client_id
+  name       = "my-service-2024"
+  alg        = "RS256"
+  pem        = file("${path.module}/my-service.pub.pem")
+  expires_at = "2025-01-01T00:00:00Z"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
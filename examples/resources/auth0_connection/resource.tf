@@ -33,3 +33,28 @@ EOF
     }
   }
 }
+
+# Strategies without a typed options block are configured with options_json
+resource "auth0_connection" "amazon" {
+  name     = "Amazon-Connection"
+  strategy = "amazon"
+  options_json = jsonencode({
+    client_id     = "client-id"
+    client_secret = "client-secret"
+  })
+}
+
+# Custom database scripts can be loaded from files, only their hashes are
+# kept in the state
+resource "auth0_connection" "custom_db" {
+  name     = "Custom-Database-Connection"
+  strategy = "auth0"
+  options {
+    enabled_database_customization = true
+    import_mode                    = true
+    custom_script_files = {
+      login    = "${path.module}/scripts/login.js"
+      get_user = "${path.module}/scripts/get_user.js"
+    }
+  }
+}
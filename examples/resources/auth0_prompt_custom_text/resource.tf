@@ -0,0 +1,10 @@
+resource "auth0_prompt_custom_text" "login_en" {
+  prompt   = "login"
+  language = "en"
+  body = jsonencode({
+    login = {
+      title       = "Welcome to {companyName}"
+      description = "Login to {companyName}"
+    }
+  })
+}
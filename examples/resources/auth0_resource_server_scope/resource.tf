@@ -0,0 +1,12 @@
+resource "auth0_resource_server" "appointments" {
+  name                   = "Appointments API"
+  identifier             = "https://appointments.example.com/"
+  ignore_external_scopes = true
+}
+
+# Declared by the module of the team owning the appointments.
+resource "auth0_resource_server_scope" "read_appointments" {
+  resource_server_identifier = auth0_resource_server.appointments.identifier
+  scope                      = "read:appointments"
+  description                = "Read the appointments"
+}
@@ -0,0 +1,26 @@
+resource "auth0_rule" "first" {
+  name    = "first-rule"
+  script  = <<EOF
+function (user, context, callback) {
+  callback(null, user, context);
+}
+EOF
+  enabled = true
+}
+
+resource "auth0_rule" "second" {
+  name    = "second-rule"
+  script  = <<EOF
+function (user, context, callback) {
+  callback(null, user, context);
+}
+EOF
+  enabled = true
+}
+
+resource "auth0_rule_order" "order" {
+  rule_ids = [
+    auth0_rule.first.id,
+    auth0_rule.second.id,
+  ]
+}
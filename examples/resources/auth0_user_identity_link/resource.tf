@@ -0,0 +1,12 @@
+resource "auth0_user" "john" {
+  connection_name = "Username-Password-Authentication"
+  email           = "john@example.com"
+  password        = "passpass$12$12"
+}
+
+# Link the Google account of John to his database user.
+resource "auth0_user_identity_link" "john_google" {
+  primary_user_id    = auth0_user.john.id
+  secondary_provider = "google-oauth2"
+  secondary_user_id  = "google-oauth2|103547991597142817347"
+}
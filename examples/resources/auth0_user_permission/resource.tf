@@ -0,0 +1,21 @@
+resource "auth0_resource_server" "reports" {
+  name       = "Reports API"
+  identifier = "https://reports.example.com/"
+
+  scopes {
+    value       = "read:reports"
+    description = "Read the reports"
+  }
+}
+
+resource "auth0_user" "reporting_service" {
+  connection_name = "Username-Password-Authentication"
+  email           = "reporting-service@example.com"
+  password        = "passpass$12$12"
+}
+
+resource "auth0_user_permission" "read_reports" {
+  user_id                    = auth0_user.reporting_service.id
+  resource_server_identifier = auth0_resource_server.reports.identifier
+  permission                 = "read:reports"
+}
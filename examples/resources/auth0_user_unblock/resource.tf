@@ -0,0 +1,8 @@
+resource "auth0_user_unblock" "john" {
+  user_id = "auth0|5f7c8ec7c33c6c004bbafe82"
+
+  # Change the content of the map to unblock the user again.
+  trigger = {
+    ticket = "SUP-1234"
+  }
+}
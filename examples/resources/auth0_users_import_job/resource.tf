@@ -0,0 +1,16 @@
+resource "auth0_connection" "legacy" {
+  name     = "Legacy-Users"
+  strategy = "auth0"
+}
+
+resource "auth0_users_import_job" "legacy" {
+  connection_id         = auth0_connection.legacy.id
+  users_file            = "${path.module}/users.json"
+  upsert                = true
+  external_id           = "legacy-idp-migration"
+  send_completion_email = false
+}
+
+output "failed_users" {
+  value = auth0_users_import_job.legacy.errors
+}